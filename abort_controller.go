@@ -0,0 +1,42 @@
+//go:build js
+
+package goji
+
+import "syscall/js"
+
+func init() {
+	AbortController = abortControllerJS(js.Global().Get("AbortController"))
+}
+
+type abortControllerJS js.Value
+
+// AbortController is a wrapper for the AbortController global interface.
+//
+// https://developer.mozilla.org/en-US/docs/Web/API/AbortController
+var AbortController abortControllerJS
+
+// New wraps the AbortController constructor.
+//
+// https://developer.mozilla.org/en-US/docs/Web/API/AbortController/AbortController
+func (a abortControllerJS) New() AbortControllerValue {
+	res := js.Value(a).New()
+	return AbortControllerValue(res)
+}
+
+// AbortControllerValue is an instance of AbortController.
+type AbortControllerValue js.Value
+
+// Signal returns the AbortController signal property.
+//
+// https://developer.mozilla.org/en-US/docs/Web/API/AbortController/signal
+func (a AbortControllerValue) Signal() AbortSignalValue {
+	res := js.Value(a).Get("signal")
+	return AbortSignalValue(res)
+}
+
+// Abort wraps the AbortController abort instance method.
+//
+// https://developer.mozilla.org/en-US/docs/Web/API/AbortController/abort
+func (a AbortControllerValue) Abort(reason js.Value) {
+	js.Value(a).Call("abort", reason)
+}
@@ -0,0 +1,22 @@
+//go:build js
+
+package goji
+
+import (
+	"syscall/js"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAbortControllerAbort(t *testing.T) {
+	controller := AbortController.New()
+	signal := controller.Signal()
+	assert.False(t, signal.Aborted())
+
+	reason := js.ValueOf("test reason")
+	controller.Abort(reason)
+
+	assert.True(t, signal.Aborted())
+	assert.Equal(t, reason, signal.Reason())
+}
@@ -0,0 +1,148 @@
+//go:build js
+
+package goji
+
+import (
+	"context"
+	"errors"
+	"syscall/js"
+)
+
+// AbortEvent is fired when the signal is aborted.
+//
+// https://developer.mozilla.org/en-US/docs/Web/API/AbortSignal/abort_event
+const AbortEvent = "abort"
+
+func init() {
+	AbortSignal = abortSignalJS(js.Global().Get("AbortSignal"))
+}
+
+type abortSignalJS js.Value
+
+// AbortSignal is a wrapper for the AbortSignal global interface.
+//
+// https://developer.mozilla.org/en-US/docs/Web/API/AbortSignal
+var AbortSignal abortSignalJS
+
+// Abort wraps the AbortSignal abort static method.
+//
+// https://developer.mozilla.org/en-US/docs/Web/API/AbortSignal/abort_static
+func (a abortSignalJS) Abort(reason js.Value) AbortSignalValue {
+	res := js.Value(a).Call("abort", reason)
+	return AbortSignalValue(res)
+}
+
+// Any wraps the AbortSignal any static method.
+//
+// https://developer.mozilla.org/en-US/docs/Web/API/AbortSignal/any_static
+func (a abortSignalJS) Any(signals ...AbortSignalValue) AbortSignalValue {
+	iterable := make([]any, len(signals))
+	for i, s := range signals {
+		iterable[i] = js.Value(s)
+	}
+	res := js.Value(a).Call("any", iterable)
+	return AbortSignalValue(res)
+}
+
+// Timeout wraps the AbortSignal timeout static method.
+//
+// https://developer.mozilla.org/en-US/docs/Web/API/AbortSignal/timeout_static
+func (a abortSignalJS) Timeout(time int) AbortSignalValue {
+	res := js.Value(a).Call("timeout", time)
+	return AbortSignalValue(res)
+}
+
+// AbortSignalValue is an instance of AbortSignal.
+type AbortSignalValue js.Value
+
+// Aborted returns the AbortSignal aborted property.
+//
+// https://developer.mozilla.org/en-US/docs/Web/API/AbortSignal/aborted
+func (a AbortSignalValue) Aborted() bool {
+	return js.Value(a).Get("aborted").Bool()
+}
+
+// Reason returns the AbortSignal reason property.
+//
+// https://developer.mozilla.org/en-US/docs/Web/API/AbortSignal/reason
+func (a AbortSignalValue) Reason() js.Value {
+	return js.Value(a).Get("reason")
+}
+
+// ThrowIfAborted wraps the AbortSignal throwIfAborted instance method.
+//
+// https://developer.mozilla.org/en-US/docs/Web/API/AbortSignal/throwIfAborted
+func (a AbortSignalValue) ThrowIfAborted() {
+	js.Value(a).Call("throwIfAborted")
+}
+
+// EventTarget returns the EventTarget for the signal.
+func (a AbortSignalValue) EventTarget() EventTargetValue {
+	return EventTargetValue(a)
+}
+
+// SignalFromContext returns an AbortSignal that is aborted when the given context is done.
+//
+// The abort reason is a DOMException named AbortError when the context
+// is cancelled, a DOMException named TimeoutError when the deadline is
+// exceeded, or an Error wrapping the context cause otherwise.
+func SignalFromContext(ctx context.Context) AbortSignalValue {
+	controller := AbortController.New()
+	context.AfterFunc(ctx, func() {
+		controller.Abort(abortReason(context.Cause(ctx)))
+	})
+	return controller.Signal()
+}
+
+// ContextFromSignal returns a context that is cancelled when the given signal is aborted.
+//
+// The cause of the returned context is the signal reason. Calling the
+// returned cancel func releases the resources associated with the context.
+func ContextFromSignal(signal AbortSignalValue) (context.Context, context.CancelFunc) {
+	ctx, cancel := context.WithCancelCause(context.Background())
+	if signal.Aborted() {
+		cancel(abortCause(signal.Reason()))
+		return ctx, func() { cancel(context.Canceled) }
+	}
+
+	var listener js.Func
+	listener = FuncOf(func(this js.Value, args []js.Value) any {
+		cancel(abortCause(signal.Reason()))
+		return js.Undefined()
+	})
+	signal.EventTarget().AddEventListener(AbortEvent, listener.Value, EventListenerOptions.WithOnce(true))
+
+	context.AfterFunc(ctx, func() {
+		signal.EventTarget().RemoveEventListener(AbortEvent, listener.Value, js.Undefined())
+		ReleaseFunc(listener)
+	})
+	return ctx, func() { cancel(context.Canceled) }
+}
+
+// abortReason returns the abort reason for the given context cause.
+func abortReason(cause error) js.Value {
+	switch {
+	case errors.Is(cause, context.Canceled):
+		return js.Value(DOMException.New(cause.Error(), "AbortError"))
+
+	case errors.Is(cause, context.DeadlineExceeded):
+		return js.Value(DOMException.New(cause.Error(), "TimeoutError"))
+
+	default:
+		return js.Value(WrapError(cause))
+	}
+}
+
+// abortCause returns the context cause for the given abort reason.
+func abortCause(reason js.Value) error {
+	switch reason.Type() {
+	case js.TypeUndefined, js.TypeNull:
+		return context.Canceled
+
+	case js.TypeObject:
+		return ErrorFromJS(reason)
+
+	default:
+		return errors.New(js.Global().Call("String", reason).String())
+	}
+}
@@ -0,0 +1,95 @@
+//go:build js
+
+package goji
+
+import (
+	"context"
+	"errors"
+	"syscall/js"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAbortSignalAbort(t *testing.T) {
+	signal := AbortSignal.Abort(js.ValueOf("test reason"))
+	assert.True(t, signal.Aborted())
+	assert.Equal(t, "test reason", signal.Reason().String())
+}
+
+func TestAbortSignalAny(t *testing.T) {
+	controller := AbortController.New()
+	signal := AbortSignal.Any(controller.Signal(), AbortController.New().Signal())
+	assert.False(t, signal.Aborted())
+
+	controller.Abort(js.ValueOf("test reason"))
+	assert.True(t, signal.Aborted())
+}
+
+func TestSignalFromContextCancel(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	signal := SignalFromContext(ctx)
+	assert.False(t, signal.Aborted())
+
+	aborted := make(chan struct{})
+	listener := EventListener(func(event EventValue) {
+		close(aborted)
+	})
+	defer listener.Release()
+
+	signal.EventTarget().AddEventListener(AbortEvent, listener.Value)
+	cancel()
+	<-aborted
+
+	assert.True(t, signal.Aborted())
+	assert.Equal(t, "AbortError", signal.Reason().Get("name").String())
+}
+
+func TestSignalFromContextDeadline(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Millisecond)
+	defer cancel()
+
+	signal := SignalFromContext(ctx)
+	<-ctx.Done()
+	time.Sleep(10 * time.Millisecond)
+
+	assert.True(t, signal.Aborted())
+	assert.Equal(t, "TimeoutError", signal.Reason().Get("name").String())
+}
+
+func TestSignalFromContextCause(t *testing.T) {
+	ctx, cancel := context.WithCancelCause(context.Background())
+	signal := SignalFromContext(ctx)
+
+	cancel(errors.New("test cause"))
+	time.Sleep(10 * time.Millisecond)
+
+	assert.True(t, signal.Aborted())
+	assert.Equal(t, "test cause", signal.Reason().Get("message").String())
+}
+
+func TestContextFromSignal(t *testing.T) {
+	controller := AbortController.New()
+
+	ctx, cancel := ContextFromSignal(controller.Signal())
+	defer cancel()
+	require.NoError(t, ctx.Err())
+
+	controller.Abort(js.Value(Error.New("test reason")))
+	<-ctx.Done()
+
+	assert.ErrorIs(t, ctx.Err(), context.Canceled)
+	assert.Equal(t, "test reason", context.Cause(ctx).Error())
+}
+
+func TestContextFromSignalAborted(t *testing.T) {
+	signal := AbortSignal.Abort(js.ValueOf("test reason"))
+
+	ctx, cancel := ContextFromSignal(signal)
+	defer cancel()
+
+	assert.ErrorIs(t, ctx.Err(), context.Canceled)
+	assert.Equal(t, "test reason", context.Cause(ctx).Error())
+}
@@ -0,0 +1,62 @@
+//go:build js
+
+package goji
+
+import "syscall/js"
+
+func init() {
+	AggregateError = aggregateErrorJS(js.Global().Get("AggregateError"))
+}
+
+type aggregateErrorJS js.Value
+
+// AggregateError is a wrapper for the AggregateError global object.
+//
+// https://developer.mozilla.org/en-US/docs/Web/JavaScript/Reference/Global_Objects/AggregateError
+var AggregateError aggregateErrorJS
+
+// New wraps the AggregateError constructor.
+//
+// https://developer.mozilla.org/en-US/docs/Web/JavaScript/Reference/Global_Objects/AggregateError/AggregateError
+func (a aggregateErrorJS) New(errors js.Value, message string) AggregateErrorValue {
+	res := js.Value(a).New(errors, message)
+	return AggregateErrorValue(res)
+}
+
+var _ (error) = (AggregateErrorValue)(js.Undefined())
+
+// AggregateErrorValue is an instance of AggregateError.
+//
+// It supports the same semantics as errors.Join so that
+// errors.Is and errors.As match any of the aggregated errors.
+type AggregateErrorValue js.Value
+
+// Error wraps the AggregateError toString prototype method.
+//
+// https://developer.mozilla.org/en-US/docs/Web/JavaScript/Reference/Global_Objects/Error/toString
+func (v AggregateErrorValue) Error() string {
+	return js.Value(v).Call("toString").String()
+}
+
+// Errors returns the AggregateError errors property.
+//
+// https://developer.mozilla.org/en-US/docs/Web/JavaScript/Reference/Global_Objects/AggregateError/errors
+func (v AggregateErrorValue) Errors() []error {
+	values := js.Value(v).Get("errors")
+	errs := make([]error, values.Length())
+	for i := range errs {
+		errs[i] = ErrorFromJS(values.Index(i))
+	}
+	return errs
+}
+
+// Unwrap returns the aggregated errors.
+func (v AggregateErrorValue) Unwrap() []error {
+	return v.Errors()
+}
+
+// Is returns true if the target is an AggregateErrorValue referring to the same JS object.
+func (v AggregateErrorValue) Is(target error) bool {
+	other, ok := target.(AggregateErrorValue)
+	return ok && js.Value(v).Equal(js.Value(other))
+}
@@ -0,0 +1,29 @@
+//go:build js
+
+package goji
+
+import (
+	"errors"
+	"syscall/js"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAggregateErrorNew(t *testing.T) {
+	first := Error.New("first")
+	second := Error.New("second")
+
+	err := AggregateError.New(js.ValueOf([]any{js.Value(first), js.Value(second)}), "test message")
+	assert.Equal(t, "AggregateError: test message", err.Error())
+	assert.Equal(t, []error{first, second}, err.Errors())
+}
+
+func TestAggregateErrorIs(t *testing.T) {
+	first := Error.New("first")
+	other := Error.New("other")
+
+	err := AggregateError.New(js.ValueOf([]any{js.Value(first)}), "test message")
+	assert.True(t, errors.Is(err, first))
+	assert.False(t, errors.Is(err, other))
+}
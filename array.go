@@ -350,3 +350,130 @@ func (a ArrayValue) With(index int, value any) ArrayValue {
 	res := js.Value(a).Call("with", index, value)
 	return ArrayValue(res)
 }
+
+// ArrayFromSlice is a helper function that returns a new array
+// containing the elements of the given slice marshalled with MarshalJS.
+func ArrayFromSlice[T any](src []T) (ArrayValue, error) {
+	dst := Array.New(len(src))
+	for i, v := range src {
+		value, err := MarshalJS(v)
+		if err != nil {
+			return ArrayValue(js.Undefined()), err
+		}
+		js.Value(dst).SetIndex(i, value)
+	}
+	return dst, nil
+}
+
+// SliceFromArray is a helper function that returns a new slice
+// containing the elements of the given array unmarshalled with UnmarshalJS.
+func SliceFromArray[T any](src ArrayValue) ([]T, error) {
+	dst := make([]T, src.Length())
+	for i := range dst {
+		err := UnmarshalJS(js.Value(src).Index(i), &dst[i])
+		if err != nil {
+			return nil, err
+		}
+	}
+	return dst, nil
+}
+
+// EveryFunc is a helper function that calls the array every
+// instance method with the given Go func as the callback.
+func (a ArrayValue) EveryFunc(fn func(value js.Value, index int) bool) bool {
+	callback := FuncOf(func(this js.Value, args []js.Value) any {
+		return fn(args[0], args[1].Int())
+	})
+	defer ReleaseFunc(callback)
+
+	return a.Every(callback.Value, js.Undefined())
+}
+
+// FilterFunc is a helper function that calls the array filter
+// instance method with the given Go func as the callback.
+func (a ArrayValue) FilterFunc(fn func(value js.Value, index int) bool) ArrayValue {
+	callback := FuncOf(func(this js.Value, args []js.Value) any {
+		return fn(args[0], args[1].Int())
+	})
+	defer ReleaseFunc(callback)
+
+	return a.Filter(callback.Value, js.Undefined())
+}
+
+// FindFunc is a helper function that calls the array find
+// instance method with the given Go func as the callback.
+func (a ArrayValue) FindFunc(fn func(value js.Value, index int) bool) js.Value {
+	callback := FuncOf(func(this js.Value, args []js.Value) any {
+		return fn(args[0], args[1].Int())
+	})
+	defer ReleaseFunc(callback)
+
+	return a.Find(callback.Value, js.Undefined())
+}
+
+// FlatMapFunc is a helper function that calls the array flatMap
+// instance method with the given Go func as the callback.
+func (a ArrayValue) FlatMapFunc(fn func(value js.Value, index int) any) ArrayValue {
+	callback := FuncOf(func(this js.Value, args []js.Value) any {
+		return fn(args[0], args[1].Int())
+	})
+	defer ReleaseFunc(callback)
+
+	return a.FlatMap(callback.Value, js.Undefined())
+}
+
+// ForEachFunc is a helper function that calls the array forEach
+// instance method with the given Go func as the callback.
+func (a ArrayValue) ForEachFunc(fn func(value js.Value, index int)) {
+	callback := FuncOf(func(this js.Value, args []js.Value) any {
+		fn(args[0], args[1].Int())
+		return js.Undefined()
+	})
+	defer ReleaseFunc(callback)
+
+	a.ForEach(callback.Value, js.Undefined())
+}
+
+// MapFunc is a helper function that calls the array map
+// instance method with the given Go func as the callback.
+func (a ArrayValue) MapFunc(fn func(value js.Value, index int) any) ArrayValue {
+	callback := FuncOf(func(this js.Value, args []js.Value) any {
+		return fn(args[0], args[1].Int())
+	})
+	defer ReleaseFunc(callback)
+
+	return a.Map(callback.Value, js.Undefined())
+}
+
+// ReduceFunc is a helper function that calls the array reduce
+// instance method with the given Go func as the callback.
+func (a ArrayValue) ReduceFunc(fn func(accumulator js.Value, value js.Value, index int) any, initialValue js.Value) js.Value {
+	callback := FuncOf(func(this js.Value, args []js.Value) any {
+		return fn(args[0], args[1], args[2].Int())
+	})
+	defer ReleaseFunc(callback)
+
+	return a.Reduce(callback.Value, initialValue)
+}
+
+// SomeFunc is a helper function that calls the array some
+// instance method with the given Go func as the callback.
+func (a ArrayValue) SomeFunc(fn func(value js.Value, index int) bool) bool {
+	callback := FuncOf(func(this js.Value, args []js.Value) any {
+		return fn(args[0], args[1].Int())
+	})
+	defer ReleaseFunc(callback)
+
+	return a.Some(callback.Value, js.Undefined())
+}
+
+// SortFunc is a helper function that calls the array sort
+// instance method with the given Go func as the compare function.
+func (a ArrayValue) SortFunc(fn func(x, y js.Value) int) ArrayValue {
+	callback := FuncOf(func(this js.Value, args []js.Value) any {
+		return fn(args[0], args[1])
+	})
+	defer ReleaseFunc(callback)
+
+	return a.Sort(callback.Value)
+}
@@ -0,0 +1,112 @@
+//go:build js
+
+package goji
+
+import "syscall/js"
+
+func init() {
+	ArrayBuffer = arrayBufferJS(js.Global().Get("ArrayBuffer"))
+}
+
+type arrayBufferJS js.Value
+
+// ArrayBuffer is a wrapper for the ArrayBuffer global object.
+//
+// https://developer.mozilla.org/en-US/docs/Web/JavaScript/Reference/Global_Objects/ArrayBuffer
+var ArrayBuffer arrayBufferJS
+
+// ArrayBufferValue is an instance of ArrayBuffer.
+type ArrayBufferValue js.Value
+
+// New wraps the array buffer constructor.
+//
+// https://developer.mozilla.org/en-US/docs/Web/JavaScript/Reference/Global_Objects/ArrayBuffer/ArrayBuffer
+func (a arrayBufferJS) New(length int, opts ...arrayBufferOption) ArrayBufferValue {
+	switch {
+	case len(opts) > 0:
+		options := js.ValueOf(map[string]any{})
+		for _, opt := range opts {
+			opt(options)
+		}
+		res := js.Value(a).New(length, options)
+		return ArrayBufferValue(res)
+
+	default:
+		res := js.Value(a).New(length)
+		return ArrayBufferValue(res)
+	}
+}
+
+// IsView wraps the array buffer isView static method.
+//
+// https://developer.mozilla.org/en-US/docs/Web/JavaScript/Reference/Global_Objects/ArrayBuffer/isView
+func (a arrayBufferJS) IsView(value js.Value) bool {
+	return js.Value(a).Call("isView", value).Bool()
+}
+
+// ByteLength wraps the array buffer byteLength property.
+//
+// https://developer.mozilla.org/en-US/docs/Web/JavaScript/Reference/Global_Objects/ArrayBuffer/byteLength
+func (a ArrayBufferValue) ByteLength() int {
+	return js.Value(a).Get("byteLength").Int()
+}
+
+// Detached wraps the array buffer detached property.
+//
+// https://developer.mozilla.org/en-US/docs/Web/JavaScript/Reference/Global_Objects/ArrayBuffer/detached
+func (a ArrayBufferValue) Detached() bool {
+	return js.Value(a).Get("detached").Bool()
+}
+
+// MaxByteLength wraps the array buffer maxByteLength property.
+//
+// https://developer.mozilla.org/en-US/docs/Web/JavaScript/Reference/Global_Objects/ArrayBuffer/maxByteLength
+func (a ArrayBufferValue) MaxByteLength() int {
+	return js.Value(a).Get("maxByteLength").Int()
+}
+
+// Resizable wraps the array buffer resizable property.
+//
+// https://developer.mozilla.org/en-US/docs/Web/JavaScript/Reference/Global_Objects/ArrayBuffer/resizable
+func (a ArrayBufferValue) Resizable() bool {
+	return js.Value(a).Get("resizable").Bool()
+}
+
+// Resize wraps the array buffer resize instance method.
+//
+// https://developer.mozilla.org/en-US/docs/Web/JavaScript/Reference/Global_Objects/ArrayBuffer/resize
+func (a ArrayBufferValue) Resize(newLength int) {
+	js.Value(a).Call("resize", newLength)
+}
+
+// Slice wraps the array buffer slice instance method.
+//
+// https://developer.mozilla.org/en-US/docs/Web/JavaScript/Reference/Global_Objects/ArrayBuffer/slice
+func (a ArrayBufferValue) Slice(start, end int) ArrayBufferValue {
+	res := js.Value(a).Call("slice", start, end)
+	return ArrayBufferValue(res)
+}
+
+// Transfer wraps the array buffer transfer instance method.
+//
+// https://developer.mozilla.org/en-US/docs/Web/JavaScript/Reference/Global_Objects/ArrayBuffer/transfer
+func (a ArrayBufferValue) Transfer(newByteLength int) ArrayBufferValue {
+	res := js.Value(a).Call("transfer", newByteLength)
+	return ArrayBufferValue(res)
+}
+
+// ArrayBufferOptions is used to set array buffer options.
+var ArrayBufferOptions = &arrayBufferOptions{}
+
+type arrayBufferOptions struct{}
+
+type arrayBufferOption func(value js.Value)
+
+// WithMaxByteLength sets the maxByteLength option.
+//
+// https://developer.mozilla.org/en-US/docs/Web/JavaScript/Reference/Global_Objects/ArrayBuffer/ArrayBuffer#maxbytelength
+func (a arrayBufferOptions) WithMaxByteLength(maxByteLength int) arrayBufferOption {
+	return func(value js.Value) {
+		value.Set("maxByteLength", maxByteLength)
+	}
+}
@@ -0,0 +1,39 @@
+//go:build js
+
+package goji
+
+import (
+	"syscall/js"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestArrayBufferNew(t *testing.T) {
+	buffer := ArrayBuffer.New(8)
+	assert.Equal(t, 8, buffer.ByteLength())
+	assert.False(t, buffer.Resizable())
+}
+
+func TestArrayBufferResize(t *testing.T) {
+	buffer := ArrayBuffer.New(8, ArrayBufferOptions.WithMaxByteLength(16))
+	assert.True(t, buffer.Resizable())
+	assert.Equal(t, 16, buffer.MaxByteLength())
+
+	buffer.Resize(12)
+	assert.Equal(t, 12, buffer.ByteLength())
+}
+
+func TestArrayBufferSlice(t *testing.T) {
+	buffer := Uint8ArrayFromBytes([]byte{1, 2, 3, 4}).Buffer()
+
+	slice := buffer.Slice(1, 3)
+	assert.Equal(t, 2, slice.ByteLength())
+	assert.Equal(t, []byte{2, 3}, BytesFromUint8Array(Uint8Array.NewFromBuffer(slice, 0, 2)))
+}
+
+func TestArrayBufferIsView(t *testing.T) {
+	value := Uint8Array.New(1)
+	assert.True(t, ArrayBuffer.IsView(js.Value(value)))
+	assert.False(t, ArrayBuffer.IsView(js.Value(value.Buffer())))
+}
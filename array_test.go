@@ -7,6 +7,7 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestNewArray(t *testing.T) {
@@ -45,3 +46,72 @@ func TestArrayJoin(t *testing.T) {
 	res := Array.Of("one", "two", "three").Join("+")
 	assert.Equal(t, "one+two+three", res)
 }
+
+func TestArrayFromSlice(t *testing.T) {
+	arr, err := ArrayFromSlice([]customType{{Name: "Alice", Age: 42}, {Name: "Bob", Age: 41}})
+	require.NoError(t, err)
+	assert.Equal(t, 2, arr.Length())
+	assert.Equal(t, "Bob", arr.At(1).Get("name").String())
+
+	bytes, err := ArrayFromSlice([]byte{1, 2})
+	require.NoError(t, err)
+	assert.True(t, Array.IsArray(js.Value(bytes)))
+	assert.Equal(t, 2, bytes.At(1).Int())
+}
+
+func TestSliceFromArray(t *testing.T) {
+	res, err := SliceFromArray[string](Array.Of("one", "two"))
+	require.NoError(t, err)
+	assert.Equal(t, []string{"one", "two"}, res)
+
+	_, err = SliceFromArray[int](Array.Of("one"))
+	var typeErr *UnmarshalTypeError
+	assert.ErrorAs(t, err, &typeErr)
+}
+
+func TestArrayCallbackFuncs(t *testing.T) {
+	arr := Array.Of(1, 2, 3, 4)
+
+	mapped := arr.MapFunc(func(value js.Value, index int) any {
+		return value.Int() * 10
+	})
+	assert.Equal(t, "10,20,30,40", mapped.Join(","))
+
+	filtered := arr.FilterFunc(func(value js.Value, index int) bool {
+		return index%2 == 0
+	})
+	assert.Equal(t, "1,3", filtered.Join(","))
+
+	found := arr.FindFunc(func(value js.Value, index int) bool {
+		return value.Int() > 2
+	})
+	assert.Equal(t, 3, found.Int())
+
+	assert.True(t, arr.EveryFunc(func(value js.Value, index int) bool {
+		return value.Int() > 0
+	}))
+	assert.False(t, arr.SomeFunc(func(value js.Value, index int) bool {
+		return value.Int() > 4
+	}))
+
+	sum := arr.ReduceFunc(func(accumulator js.Value, value js.Value, index int) any {
+		return accumulator.Int() + value.Int()
+	}, js.ValueOf(0))
+	assert.Equal(t, 10, sum.Int())
+
+	flat := arr.FlatMapFunc(func(value js.Value, index int) any {
+		return []any{value, value}
+	})
+	assert.Equal(t, 8, flat.Length())
+
+	var visited []int
+	arr.ForEachFunc(func(value js.Value, index int) {
+		visited = append(visited, index)
+	})
+	assert.Equal(t, []int{0, 1, 2, 3}, visited)
+
+	sorted := arr.SortFunc(func(x, y js.Value) int {
+		return y.Int() - x.Int()
+	})
+	assert.Equal(t, "4,3,2,1", sorted.Join(","))
+}
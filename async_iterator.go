@@ -3,14 +3,34 @@
 package goji
 
 import (
+	"context"
+	"errors"
+	"iter"
+	"sync"
 	"syscall/js"
+	"time"
 )
 
 // AsyncIteratorOf wraps the given channel into an async iterator object.
+//
+// The funcs backing the iterator are released once the iterator
+// object is garbage collected. Use Scope.AsyncIteratorOf to
+// release them with a scope instead.
 func AsyncIteratorOf(from <-chan any) js.Value {
-	object := js.Global().Get("Object")
+	object := js.Global().Get("Object").New()
+	return asyncIteratorOf(object, from, func(fn func(this js.Value, args []js.Value) any) js.Func {
+		return FuncOfAuto(object, fn)
+	})
+}
+
+// asyncIteratorOf turns the given object into an async iterator over
+// the given channel using the given funcOf to create the iterator funcs.
+//
+// The object is its own async iterator, so the funcs
+// are reachable for as long as the object is.
+func asyncIteratorOf(object js.Value, from <-chan any, funcOf func(fn func(this js.Value, args []js.Value) any) js.Func) js.Value {
 	symbol := js.Global().Get("Symbol").Get("asyncIterator")
-	next := js.FuncOf(func(this js.Value, args []js.Value) any {
+	next := funcOf(func(this js.Value, args []js.Value) any {
 		prom := PromiseOf(func(resolve, reject func(value js.Value)) {
 			v, ok := <-from
 			if !ok {
@@ -21,43 +41,265 @@ func AsyncIteratorOf(from <-chan any) js.Value {
 		})
 		return js.Value(prom)
 	})
-	value := js.FuncOf(func(this js.Value, args []js.Value) any {
-		return js.ValueOf(map[string]any{"next": next})
+	iterator := funcOf(func(this js.Value, args []js.Value) any {
+		return this
 	})
-	return object.Call("defineProperty", object.New(), symbol, map[string]any{"value": value})
+	object.Set("next", next)
+	js.Global().Get("Object").Call("defineProperty", object, symbol, map[string]any{"value": iterator})
+	return object
 }
 
-type AsyncIteratorResult struct {
-	Value js.Value
-	Error error
-}
+// AsyncIteratorOfContext returns an async iterator object that yields
+// the values sent by the given produce func marshalled with MarshalJS.
+//
+// The produce func is called in a new goroutine and must return once
+// the given context is done. Calling return or throw on the iterator,
+// such as when a for await loop exits early, cancels the context and
+// discards any values that are still being sent. If the produce func
+// returns an error, the next pending call to next rejects with it.
+//
+// The funcs backing the iterator are released once the iterator
+// object is garbage collected.
+func AsyncIteratorOfContext[T any](ctx context.Context, produce func(ctx context.Context, out chan<- T) error) js.Value {
+	ctx, cancel := context.WithCancel(ctx)
 
-// ForAwaitOf is a helper that wraps an async iterator in a channel.
-func ForAwaitOf(value js.Value) <-chan AsyncIteratorResult {
-	object := js.Global().Get("Object")
-	symbol := js.Global().Get("Symbol").Get("asyncIterator")
-	desc := object.Call("getOwnPropertyDescriptor", value, symbol)
-	iter := desc.Call("value")
-	result := make(chan AsyncIteratorResult)
+	values := make(chan T)
+	done := make(chan struct{})
+
+	var err error
 	go func() {
-		defer close(result)
+		defer close(done)
+		err = produce(ctx, values)
+	}()
+
+	// the lock protects the iterator state
+	// from concurrent calls to next
+	var mu sync.Mutex
+	var finished bool
+
+	// finish cancels the producer and discards the values it
+	// sends, returning false if the iterator is already finished
+	finish := func() bool {
+		mu.Lock()
+		defer mu.Unlock()
+
+		if finished {
+			return false
+		}
+		finished = true
+		cancel()
+		go func() {
+			for {
+				select {
+				case <-values:
+				case <-done:
+					return
+				}
+			}
+		}()
+		return true
+	}
+
+	object := js.Global().Get("Object").New()
+	funcOf := func(fn func(this js.Value, args []js.Value) any) js.Func {
+		return FuncOfAuto(object, fn)
+	}
+
+	symbol := js.Global().Get("Symbol").Get("asyncIterator")
+	next := funcOf(func(this js.Value, args []js.Value) any {
+		prom := PromiseOf(func(resolve, reject func(value js.Value)) {
+			mu.Lock()
+			closed := finished
+			mu.Unlock()
+
+			if closed {
+				resolve(js.ValueOf(map[string]any{"done": true}))
+				return
+			}
+			select {
+			case v := <-values:
+				mu.Lock()
+				closed := finished
+				mu.Unlock()
+
+				// the value is discarded if the iterator
+				// was closed while waiting for it
+				if closed {
+					resolve(js.ValueOf(map[string]any{"done": true}))
+					return
+				}
+				value, err := MarshalJS(v)
+				if err != nil {
+					finish()
+					reject(js.Value(WrapError(err)))
+					return
+				}
+				resolve(js.ValueOf(map[string]any{"done": false, "value": value}))
+
+			case <-done:
+				if finish() && err != nil {
+					reject(js.Value(WrapError(err)))
+					return
+				}
+				resolve(js.ValueOf(map[string]any{"done": true}))
+			}
+		})
+		return js.Value(prom)
+	})
+	returnFn := funcOf(func(this js.Value, args []js.Value) any {
+		finish()
+		value := js.Undefined()
+		if len(args) > 0 {
+			value = args[0]
+		}
+		res := js.ValueOf(map[string]any{"done": true, "value": value})
+		return js.Value(Promise.Resolve(res))
+	})
+	throwFn := funcOf(func(this js.Value, args []js.Value) any {
+		finish()
+		reason := js.Undefined()
+		if len(args) > 0 {
+			reason = args[0]
+		}
+		return js.Value(Promise.Reject(reason))
+	})
+	iterator := funcOf(func(this js.Value, args []js.Value) any {
+		return this
+	})
+	object.Set("next", next)
+	object.Set("return", returnFn)
+	object.Set("throw", throwFn)
+	js.Global().Get("Object").Call("defineProperty", object, symbol, map[string]any{"value": iterator})
+	return object
+}
+
+// AsyncIteratorOfChan returns an async iterator object that yields
+// the values received from the given channel marshalled with MarshalJS.
+//
+// The iterator is done once the channel is closed. If errc is not nil,
+// the error received from it after the channel is closed rejects the
+// next pending call to next, so errc must receive a value or be closed.
+// Calling return or throw on the iterator stops the iteration and
+// discards the values that are still sent on the channel until it is
+// closed or the given context is done, so a sender that also stops once
+// the context is done never blocks forever.
+//
+// See AsyncIteratorOfContext for more details.
+func AsyncIteratorOfChan[T any](ctx context.Context, from <-chan T, errc <-chan error) js.Value {
+	drain := func() {
+		for {
+			select {
+			case _, ok := <-from:
+				if !ok {
+					return
+				}
+			case <-ctx.Done():
+				return
+			}
+		}
+	}
+	return AsyncIteratorOfContext(ctx, func(iterCtx context.Context, out chan<- T) error {
+		for {
+			select {
+			case v, ok := <-from:
+				if !ok {
+					if errc == nil {
+						return nil
+					}
+					select {
+					case err := <-errc:
+						return err
+					case <-iterCtx.Done():
+						return iterCtx.Err()
+					}
+				}
+				select {
+				case out <- v:
+					continue
+				case <-iterCtx.Done():
+				}
+			case <-iterCtx.Done():
+			}
+			go drain()
+			return iterCtx.Err()
+		}
+	})
+}
+
+// ErrNotAsyncIterable is returned when a value does not
+// have a Symbol.asyncIterator method.
+var ErrNotAsyncIterable = errors.New("value is not async iterable")
+
+// asyncIteratorCloseTimeout is how long ForAwaitOf waits for
+// the promise returned by the return method of an async iterator.
+const asyncIteratorCloseTimeout = time.Second
+
+// ForAwaitOf returns an iterator over the values of the given async iterable.
+//
+// The iteration stops after the first error is yielded, including the
+// errors thrown synchronously by the async iterator methods. If the
+// consumer stops early or the context is done, the return method of the
+// async iterator is called so that it can release its resources.
+func ForAwaitOf(ctx context.Context, value js.Value) iter.Seq2[js.Value, error] {
+	return func(yield func(js.Value, error) bool) {
+		if value.Type() != js.TypeObject && value.Type() != js.TypeFunction {
+			yield(js.Undefined(), ErrNotAsyncIterable)
+			return
+		}
+		symbol := js.Global().Get("Symbol").Get("asyncIterator")
+		method := js.Global().Get("Reflect").Call("get", value, symbol)
+		if method.Type() != js.TypeFunction {
+			yield(js.Undefined(), ErrNotAsyncIterable)
+			return
+		}
+		iterator, err := callJS(method, value)
+		if err != nil {
+			yield(js.Undefined(), err)
+			return
+		}
+		next := iterator.Get("next")
 		for {
-			prom := iter.Get("next").Invoke()
-			res, err := Await(PromiseValue(prom))
-			out := AsyncIteratorResult{Error: err}
-			done := js.Undefined()
-			if err == nil {
-				done = res[0].Get("done")
-				out.Value = res[0].Get("value")
-			}
-			if done.Type() == js.TypeBoolean && done.Bool() {
+			prom, err := callJS(next, iterator)
+			if err != nil {
+				yield(js.Undefined(), err)
 				return
 			}
-			result <- out
+			res, err := AwaitContext(ctx, Promise.Resolve(prom))
 			if err != nil {
+				if ctx.Err() != nil {
+					closeAsyncIterator(ctx, iterator)
+				}
+				yield(js.Undefined(), err)
+				return
+			}
+			if res[0].Get("done").Truthy() {
+				return
+			}
+			if !yield(res[0].Get("value"), nil) {
+				closeAsyncIterator(ctx, iterator)
 				return
 			}
 		}
-	}()
-	return result
+	}
+}
+
+// closeAsyncIterator calls the return method of the given
+// async iterator if it has one and waits for it to settle.
+//
+// The given context may already be done, so the wait is
+// only bounded by asyncIteratorCloseTimeout.
+func closeAsyncIterator(ctx context.Context, iterator js.Value) {
+	method := iterator.Get("return")
+	if method.Type() != js.TypeFunction {
+		return
+	}
+	res, err := callJS(method, iterator)
+	if err != nil {
+		return
+	}
+	ctx, cancel := context.WithTimeout(context.WithoutCancel(ctx), asyncIteratorCloseTimeout)
+	defer cancel()
+	// the result is always awaited so that a rejected
+	// promise is never reported as an unhandled rejection
+	AwaitContext(ctx, Promise.Resolve(res))
 }
@@ -3,9 +3,14 @@
 package goji
 
 import (
+	"context"
+	"errors"
+	"syscall/js"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestForAwaitOf(t *testing.T) {
@@ -15,17 +20,376 @@ func TestForAwaitOf(t *testing.T) {
 		input <- true
 		input <- false
 	}()
-	iter := AsyncIteratorOf(input)
-	output := ForAwaitOf(iter)
 
-	res := <-output
-	assert.NoError(t, res.Error)
-	assert.True(t, res.Value.Bool())
+	var values []bool
+	for value, err := range ForAwaitOf(context.Background(), AsyncIteratorOf(input)) {
+		require.NoError(t, err)
+		values = append(values, value.Bool())
+	}
+	assert.Equal(t, []bool{true, false}, values)
+}
+
+func TestForAwaitOfInherited(t *testing.T) {
+	value := js.Global().Call("eval", `(() => {
+		class Counter {
+			async *[Symbol.asyncIterator]() {
+				yield 1;
+				yield 2;
+			}
+		}
+		return new Counter();
+	})()`)
+
+	var values []int
+	for value, err := range ForAwaitOf(context.Background(), value) {
+		require.NoError(t, err)
+		values = append(values, value.Int())
+	}
+	assert.Equal(t, []int{1, 2}, values)
+}
+
+func TestForAwaitOfBreak(t *testing.T) {
+	value := js.Global().Call("eval", `(() => {
+		const state = { closed: false };
+		state.iter = (async function* () {
+			try {
+				yield 1;
+				yield 2;
+			} finally {
+				state.closed = true;
+			}
+		})();
+		return state;
+	})()`)
+
+	for value, err := range ForAwaitOf(context.Background(), value.Get("iter")) {
+		require.NoError(t, err)
+		assert.Equal(t, 1, value.Int())
+		break
+	}
+	assert.True(t, value.Get("closed").Bool())
+}
+
+func TestForAwaitOfCancel(t *testing.T) {
+	value := js.Global().Call("eval", `(() => {
+		const state = { closed: false };
+		state.iter = {
+			[Symbol.asyncIterator]() { return this; },
+			next() { return new Promise(() => {}); },
+			return() {
+				state.closed = true;
+				return Promise.resolve({ done: true });
+			},
+		};
+		return state;
+	})()`)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	go func() {
+		time.Sleep(10 * time.Millisecond)
+		cancel()
+	}()
+
+	var errs []error
+	for _, err := range ForAwaitOf(ctx, value.Get("iter")) {
+		errs = append(errs, err)
+	}
+	require.Len(t, errs, 1)
+	assert.ErrorIs(t, errs[0], context.Canceled)
+	assert.True(t, value.Get("closed").Bool())
+}
+
+func TestForAwaitOfCancelAwaitsReturn(t *testing.T) {
+	value := js.Global().Call("eval", `(() => {
+		const state = { closed: false };
+		state.iter = {
+			[Symbol.asyncIterator]() { return this; },
+			next() { return new Promise(() => {}); },
+			return() {
+				return new Promise((resolve) => setTimeout(() => {
+					state.closed = true;
+					resolve({ done: true });
+				}, 10));
+			},
+		};
+		return state;
+	})()`)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	go func() {
+		time.Sleep(10 * time.Millisecond)
+		cancel()
+	}()
+
+	for _, err := range ForAwaitOf(ctx, value.Get("iter")) {
+		assert.ErrorIs(t, err, context.Canceled)
+	}
+	assert.True(t, value.Get("closed").Bool())
+}
+
+func TestForAwaitOfNextThrows(t *testing.T) {
+	value := js.Global().Call("eval", `({
+		[Symbol.asyncIterator]() { return this; },
+		next() { throw new Error("failed"); },
+	})`)
+
+	var errs []error
+	for _, err := range ForAwaitOf(context.Background(), value) {
+		errs = append(errs, err)
+	}
+	require.Len(t, errs, 1)
+	assert.ErrorContains(t, errs[0], "failed")
+}
+
+func TestForAwaitOfNotIterable(t *testing.T) {
+	for _, err := range ForAwaitOf(context.Background(), js.ValueOf(1)) {
+		assert.ErrorIs(t, err, ErrNotAsyncIterable)
+	}
+}
+
+func TestAsyncIteratorOfForAwait(t *testing.T) {
+	input := make(chan any, 2)
+	input <- "one"
+	input <- "two"
+	close(input)
+
+	collect := js.Global().Call("eval", `(async (iter) => {
+		const values = [];
+		for await (const value of iter) {
+			values.push(value);
+		}
+		return values.join(",");
+	})`)
+
+	res, err := Await(PromiseValue(collect.Invoke(AsyncIteratorOf(input))))
+	require.NoError(t, err)
+	assert.Equal(t, "one,two", res[0].String())
+}
+
+func TestAsyncIteratorOfContextForAwait(t *testing.T) {
+	type item struct {
+		Name string `json:"name"`
+	}
+	iter := AsyncIteratorOfContext(context.Background(), func(ctx context.Context, out chan<- item) error {
+		for _, name := range []string{"one", "two"} {
+			select {
+			case out <- item{Name: name}:
+			case <-ctx.Done():
+				return ctx.Err()
+			}
+		}
+		return nil
+	})
+
+	collect := js.Global().Call("eval", `(async (iter) => {
+		const values = [];
+		for await (const value of iter) {
+			values.push(value.name);
+		}
+		return values.join(",");
+	})`)
+
+	res, err := Await(PromiseValue(collect.Invoke(iter)))
+	require.NoError(t, err)
+	assert.Equal(t, "one,two", res[0].String())
+}
+
+func TestAsyncIteratorOfContextBreakCancelsProducer(t *testing.T) {
+	cancelled := make(chan struct{})
+	iter := AsyncIteratorOfContext(context.Background(), func(ctx context.Context, out chan<- int) error {
+		defer close(cancelled)
+		for i := 0; ; i++ {
+			select {
+			case out <- i:
+			case <-ctx.Done():
+				return ctx.Err()
+			}
+		}
+	})
+
+	first := js.Global().Call("eval", `(async (iter) => {
+		for await (const value of iter) {
+			return value;
+		}
+	})`)
+
+	res, err := Await(PromiseValue(first.Invoke(iter)))
+	require.NoError(t, err)
+	assert.Equal(t, 0, res[0].Int())
+
+	select {
+	case <-cancelled:
+	case <-time.After(time.Second):
+		t.Fatal("producer was not cancelled")
+	}
+}
+
+func TestAsyncIteratorOfContextError(t *testing.T) {
+	iter := AsyncIteratorOfContext(context.Background(), func(ctx context.Context, out chan<- string) error {
+		out <- "one"
+		return errors.New("producer failed")
+	})
+
+	collect := js.Global().Call("eval", `(async (iter) => {
+		const values = [];
+		try {
+			for await (const value of iter) {
+				values.push(value);
+			}
+		} catch (err) {
+			values.push(err.message);
+		}
+		return values.join(",");
+	})`)
+
+	res, err := Await(PromiseValue(collect.Invoke(iter)))
+	require.NoError(t, err)
+	assert.Equal(t, "one,producer failed", res[0].String())
+
+	res, err = Await(PromiseValue(iter.Call("next")))
+	require.NoError(t, err)
+	assert.True(t, res[0].Get("done").Bool())
+}
+
+func TestAsyncIteratorOfContextCancel(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	iter := AsyncIteratorOfContext(ctx, func(ctx context.Context, out chan<- int) error {
+		<-ctx.Done()
+		return nil
+	})
+	cancel()
+
+	res, err := Await(PromiseValue(iter.Call("next")))
+	require.NoError(t, err)
+	assert.True(t, res[0].Get("done").Bool())
+}
+
+func TestAsyncIteratorOfContextNextAfterReturn(t *testing.T) {
+	iter := AsyncIteratorOfContext(context.Background(), func(ctx context.Context, out chan<- int) error {
+		for i := 0; ; i++ {
+			select {
+			case out <- i:
+			case <-ctx.Done():
+				return ctx.Err()
+			}
+		}
+	})
+
+	_, err := Await(PromiseValue(iter.Call("return")))
+	require.NoError(t, err)
+
+	for i := 0; i < 10; i++ {
+		res, err := Await(PromiseValue(iter.Call("next")))
+		require.NoError(t, err)
+		assert.True(t, res[0].Get("done").Bool())
+	}
+}
+
+func TestAsyncIteratorOfChanForAwait(t *testing.T) {
+	from := make(chan string)
+	go func() {
+		defer close(from)
+		from <- "one"
+		from <- "two"
+	}()
+	iter := AsyncIteratorOfChan(context.Background(), from, nil)
+
+	collect := js.Global().Call("eval", `(async (iter) => {
+		const values = [];
+		for await (const value of iter) {
+			values.push(value);
+		}
+		return values.join(",");
+	})`)
+
+	res, err := Await(PromiseValue(collect.Invoke(iter)))
+	require.NoError(t, err)
+	assert.Equal(t, "one,two", res[0].String())
+}
+
+func TestAsyncIteratorOfChanError(t *testing.T) {
+	from := make(chan string)
+	errc := make(chan error, 1)
+	go func() {
+		defer close(from)
+		from <- "one"
+		errc <- errors.New("producer failed")
+	}()
+	iter := AsyncIteratorOfChan(context.Background(), from, errc)
+
+	collect := js.Global().Call("eval", `(async (iter) => {
+		const values = [];
+		try {
+			for await (const value of iter) {
+				values.push(value);
+			}
+		} catch (err) {
+			values.push(err.message);
+		}
+		return values.join(",");
+	})`)
+
+	res, err := Await(PromiseValue(collect.Invoke(iter)))
+	require.NoError(t, err)
+	assert.Equal(t, "one,producer failed", res[0].String())
+}
+
+func TestAsyncIteratorOfChanBreakDrainsSender(t *testing.T) {
+	from := make(chan int)
+	sent := make(chan struct{})
+	go func() {
+		defer close(sent)
+		defer close(from)
+		for i := 0; i < 10; i++ {
+			from <- i
+		}
+	}()
+	iter := AsyncIteratorOfChan(context.Background(), from, nil)
+
+	first := js.Global().Call("eval", `(async (iter) => {
+		for await (const value of iter) {
+			return value;
+		}
+	})`)
+
+	res, err := Await(PromiseValue(first.Invoke(iter)))
+	require.NoError(t, err)
+	assert.Equal(t, 0, res[0].Int())
+
+	select {
+	case <-sent:
+	case <-time.After(time.Second):
+		t.Fatal("sender was blocked")
+	}
+}
+
+func TestAsyncIteratorOfChanBreakStopsDrainOnCancel(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	from := make(chan int)
+	go func() {
+		from <- 0
+		from <- 1
+	}()
+	iter := AsyncIteratorOfChan(ctx, from, nil)
+
+	first := js.Global().Call("eval", `(async (iter) => {
+		for await (const value of iter) {
+			return value;
+		}
+	})`)
+
+	res, err := Await(PromiseValue(first.Invoke(iter)))
+	require.NoError(t, err)
+	assert.Equal(t, 0, res[0].Int())
 
-	res = <-output
-	assert.NoError(t, res.Error)
-	assert.False(t, res.Value.Bool())
+	// the channel is never closed, so the values
+	// are only discarded until the context is done
+	cancel()
+	time.Sleep(10 * time.Millisecond)
 
-	_, ok := <-output
-	assert.False(t, ok)
+	select {
+	case from <- 2:
+		t.Fatal("value was discarded after the context was done")
+	case <-time.After(10 * time.Millisecond):
+	}
 }
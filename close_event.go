@@ -0,0 +1,100 @@
+// Code generated by gojigen from close_event.webidl. DO NOT EDIT.
+
+//go:build js
+
+package goji
+
+import "syscall/js"
+
+func init() {
+	CloseEvent = closeEventJS(js.Global().Get("CloseEvent"))
+}
+
+type closeEventJS js.Value
+
+// CloseEvent is a wrapper for the CloseEvent global interface.
+//
+// https://developer.mozilla.org/en-US/docs/Web/API/CloseEvent
+var CloseEvent closeEventJS
+
+// New wraps the CloseEvent constructor.
+//
+// https://developer.mozilla.org/en-US/docs/Web/API/CloseEvent/CloseEvent
+func (e closeEventJS) New(eventType string, opts ...eventOption) CloseEventValue {
+	switch {
+	case len(opts) > 0:
+		options := js.ValueOf(map[string]any{})
+		for _, opt := range opts {
+			opt(options)
+		}
+		res := js.Value(e).New(eventType, options)
+		return CloseEventValue(res)
+
+	default:
+		res := js.Value(e).New(eventType)
+		return CloseEventValue(res)
+	}
+}
+
+// CloseEventValue is an instance of CloseEvent.
+type CloseEventValue js.Value
+
+// Code returns the CloseEvent code property.
+//
+// https://developer.mozilla.org/en-US/docs/Web/API/CloseEvent/code
+func (e CloseEventValue) Code() int {
+	return js.Value(e).Get("code").Int()
+}
+
+// Reason returns the CloseEvent reason property.
+//
+// https://developer.mozilla.org/en-US/docs/Web/API/CloseEvent/reason
+func (e CloseEventValue) Reason() string {
+	return js.Value(e).Get("reason").String()
+}
+
+// WasClean returns the CloseEvent wasClean property.
+//
+// https://developer.mozilla.org/en-US/docs/Web/API/CloseEvent/wasClean
+func (e CloseEventValue) WasClean() bool {
+	return js.Value(e).Get("wasClean").Bool()
+}
+
+// Event returns the parent Event.
+func (e CloseEventValue) Event() EventValue {
+	return EventValue(e)
+}
+
+// CloseEventOptions is used to set CloseEvent options.
+//
+// The options in EventOptions can also be used with CloseEvent.
+var CloseEventOptions = &closeEventOptions{}
+
+type closeEventOptions struct{}
+
+// WithCode sets the code option.
+//
+// https://developer.mozilla.org/en-US/docs/Web/API/CloseEvent/CloseEvent#code
+func (e closeEventOptions) WithCode(code int) eventOption {
+	return func(value js.Value) {
+		value.Set("code", code)
+	}
+}
+
+// WithReason sets the reason option.
+//
+// https://developer.mozilla.org/en-US/docs/Web/API/CloseEvent/CloseEvent#reason
+func (e closeEventOptions) WithReason(reason string) eventOption {
+	return func(value js.Value) {
+		value.Set("reason", reason)
+	}
+}
+
+// WithWasClean sets the wasClean option.
+//
+// https://developer.mozilla.org/en-US/docs/Web/API/CloseEvent/CloseEvent#wasclean
+func (e closeEventOptions) WithWasClean(enabled bool) eventOption {
+	return func(value js.Value) {
+		value.Set("wasClean", enabled)
+	}
+}
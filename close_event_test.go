@@ -0,0 +1,26 @@
+//go:build js
+
+package goji
+
+import (
+	"syscall/js"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCloseEventNew(t *testing.T) {
+	if js.Global().Get("CloseEvent").IsUndefined() {
+		t.Skip("CloseEvent is not supported")
+	}
+
+	event := CloseEvent.New("close",
+		CloseEventOptions.WithCode(1000),
+		CloseEventOptions.WithReason("done"),
+		CloseEventOptions.WithWasClean(true),
+	)
+	assert.Equal(t, "close", event.Event().Type())
+	assert.Equal(t, 1000, event.Code())
+	assert.Equal(t, "done", event.Reason())
+	assert.True(t, event.WasClean())
+}
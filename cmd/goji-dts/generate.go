@@ -0,0 +1,968 @@
+//go:build !js
+
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"go/ast"
+	"go/token"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+// header is written at the start of the generated declarations.
+const header = "// Code generated by goji-dts. DO NOT EDIT.\n"
+
+// generator contains the state used to generate declarations.
+type generator struct {
+	pkg *pkg
+	// objects contains the types exported with goji.Export and
+	// whether their pointer receiver methods are exported too.
+	objects map[string]bool
+	// declared contains the types that have been queued for declaration.
+	declared map[string]bool
+	// queue contains the types that are waiting to be declared.
+	queue []string
+	// globals contains the TS type of each global by name.
+	globals map[string]string
+	// targets contains the typed event targets by variable or field name.
+	targets map[string]*eventTarget
+}
+
+// eventTarget is a goji.TypedEventTarget declared as a variable or field.
+type eventTarget struct {
+	// name is the prefix of the event map interface name.
+	name   string
+	detail ast.Expr
+	file   *file
+	// events contains the event types that are emitted or listened for.
+	events map[string]struct{}
+}
+
+// generate returns the declarations for the JS values exported by the given package.
+func generate(p *pkg) []byte {
+	g := &generator{
+		pkg:      p,
+		objects:  make(map[string]bool),
+		declared: make(map[string]bool),
+		globals:  make(map[string]string),
+		targets:  make(map[string]*eventTarget),
+	}
+	for _, f := range p.files {
+		g.collectTargets(f)
+	}
+	for _, f := range p.files {
+		g.collectExports(f)
+	}
+
+	var buf bytes.Buffer
+	buf.WriteString(header)
+
+	// the event maps and object interfaces are rendered
+	// first so that every type they use is queued
+	eventMaps := g.renderEventMaps()
+	objects := make(map[string]string)
+	for name := range g.objects {
+		objects[name] = g.renderObject(name)
+	}
+
+	decls := make(map[string]string)
+	for len(g.queue) > 0 {
+		name := g.queue[0]
+		g.queue = g.queue[1:]
+		if _, ok := g.objects[name]; !ok {
+			decls[name] = g.renderData(name)
+		}
+	}
+	for name, decl := range objects {
+		decls[name] = decl
+	}
+
+	for _, name := range sortedKeys(decls) {
+		buf.WriteString("\n")
+		buf.WriteString(decls[name])
+	}
+	for _, decl := range eventMaps {
+		buf.WriteString("\n")
+		buf.WriteString(decl)
+	}
+	if len(g.globals) == 0 {
+		return buf.Bytes()
+	}
+	if len(decls) == 0 && len(eventMaps) == 0 {
+		// declare global is only valid in a module
+		buf.WriteString("\nexport {};\n")
+	}
+	buf.WriteString("\ndeclare global {\n")
+	for _, name := range sortedKeys(g.globals) {
+		fmt.Fprintf(&buf, "\tvar %s: %s;\n", name, g.globals[name])
+	}
+	buf.WriteString("}\n")
+	return buf.Bytes()
+}
+
+// collectTargets finds the typed event targets declared in the given file.
+//
+// The event maps of variables are named after the variable. The event maps
+// of struct fields are named after the field's tag, or after the struct type
+// if the field is untagged, since the field itself is usually unexported.
+func (g *generator) collectTargets(f *file) {
+	add := func(name string, expr ast.Expr) *eventTarget {
+		detail := typedEventTargetDetail(f, expr)
+		if detail == nil {
+			return nil
+		}
+		if _, ok := g.targets[name]; !ok {
+			g.targets[name] = &eventTarget{name: upperFirst(name), detail: detail, file: f, events: make(map[string]struct{})}
+		}
+		return g.targets[name]
+	}
+	ast.Inspect(f.ast, func(n ast.Node) bool {
+		switch n := n.(type) {
+		case *ast.TypeSpec:
+			st, ok := n.Type.(*ast.StructType)
+			if !ok {
+				return true
+			}
+			for _, field := range st.Fields.List {
+				for _, ident := range field.Names {
+					if target := add(ident.Name, field.Type); target != nil {
+						target.name = upperFirst(n.Name.Name)
+						if tagged := fieldName(field, ""); tagged != "" && tagged != "-" {
+							target.name = upperFirst(tagged)
+						}
+					}
+				}
+			}
+
+		case *ast.ValueSpec:
+			for i, name := range n.Names {
+				if n.Type != nil {
+					add(name.Name, n.Type)
+				} else if i < len(n.Values) {
+					add(name.Name, n.Values[i])
+				}
+			}
+
+		case *ast.AssignStmt:
+			if len(n.Lhs) != len(n.Rhs) {
+				return true
+			}
+			for i, lhs := range n.Lhs {
+				switch lhs := lhs.(type) {
+				case *ast.Ident:
+					add(lhs.Name, n.Rhs[i])
+				case *ast.SelectorExpr:
+					add(lhs.Sel.Name, n.Rhs[i])
+				}
+			}
+
+		case *ast.Field:
+			for _, name := range n.Names {
+				add(name.Name, n.Type)
+			}
+		}
+		return true
+	})
+}
+
+// typedEventTargetDetail returns the detail type of the given
+// typed event target type or constructor call expr.
+func typedEventTargetDetail(f *file, expr ast.Expr) ast.Expr {
+	if star, ok := expr.(*ast.StarExpr); ok {
+		expr = star.X
+	}
+	if call, ok := expr.(*ast.CallExpr); ok {
+		index, ok := call.Fun.(*ast.IndexExpr)
+		if ok && f.isPackageSelector(index.X, gojiPath, "NewTypedEventTarget") {
+			return index.Index
+		}
+		return nil
+	}
+	index, ok := expr.(*ast.IndexExpr)
+	if ok && f.isPackageSelector(index.X, gojiPath, "TypedEventTarget") {
+		return index.Index
+	}
+	return nil
+}
+
+// collectExports finds the exported values and emitted events in the given file.
+func (g *generator) collectExports(f *file) {
+	for _, decl := range f.ast.Decls {
+		var body *ast.BlockStmt
+		if fn, ok := decl.(*ast.FuncDecl); ok {
+			body = fn.Body
+		}
+		ast.Inspect(decl, func(n ast.Node) bool {
+			call, ok := n.(*ast.CallExpr)
+			if !ok {
+				return true
+			}
+			g.collectCall(f, body, call)
+			return true
+		})
+	}
+}
+
+// collectCall records the exported value or event of the given call.
+func (g *generator) collectCall(f *file, body *ast.BlockStmt, call *ast.CallExpr) {
+	switch {
+	case f.isPackageCall(call, gojiPath, "ExportGlobal") && len(call.Args) == 2:
+		if name, ok := stringLit(call.Args[0]); ok {
+			g.globals[name] = g.exportType(f, body, call.Args[1])
+		}
+
+	case f.isPackageCall(call, gojiPath, "Export") && len(call.Args) == 1:
+		g.exportType(f, body, call.Args[0])
+
+	case isGlobalSet(f, call) && len(call.Args) == 2:
+		if name, ok := stringLit(call.Args[0]); ok {
+			g.globals[name] = g.valueType(f, body, call.Args[1], 0)
+		}
+
+	case len(call.Args) > 0:
+		sel, ok := call.Fun.(*ast.SelectorExpr)
+		if !ok || (sel.Sel.Name != "Emit" && sel.Sel.Name != "On") {
+			return
+		}
+		target := g.targets[exprName(sel.X)]
+		eventType, ok := stringLit(call.Args[0])
+		if target != nil && ok {
+			target.events[eventType] = struct{}{}
+		}
+	}
+}
+
+// isGlobalSet returns true if the given call sets a property on js.Global().
+func isGlobalSet(f *file, call *ast.CallExpr) bool {
+	sel, ok := call.Fun.(*ast.SelectorExpr)
+	if !ok || sel.Sel.Name != "Set" {
+		return false
+	}
+	global, ok := sel.X.(*ast.CallExpr)
+	return ok && f.isPackageCall(global, jsPath, "Global")
+}
+
+// valueType returns the TS type of a value set on the global object.
+func (g *generator) valueType(f *file, body *ast.BlockStmt, expr ast.Expr, depth int) string {
+	if depth > 8 {
+		return "any"
+	}
+	switch expr := expr.(type) {
+	case *ast.ParenExpr:
+		return g.valueType(f, body, expr.X, depth+1)
+
+	case *ast.BasicLit:
+		switch expr.Kind {
+		case token.STRING, token.CHAR:
+			return "string"
+		default:
+			return "number"
+		}
+
+	case *ast.Ident:
+		switch expr.Name {
+		case "true", "false":
+			return "boolean"
+		}
+		if b := g.pkg.lookup(body, expr.Name); b != nil && b.value != nil {
+			return g.valueType(f, body, b.value, depth+1)
+		}
+
+	case *ast.SelectorExpr:
+		// the Value field of a js.Func
+		if expr.Sel.Name == "Value" {
+			return g.valueType(f, body, expr.X, depth+1)
+		}
+
+	case *ast.CallExpr:
+		switch {
+		case f.isPackageCall(expr, gojiPath, "Async") && len(expr.Args) == 1:
+			return "(...args: any[]) => Promise<" + g.asyncResult(f, body, expr.Args[0]) + ">"
+		case f.isPackageCall(expr, gojiPath, "FuncOf", "FuncOfAuto"), f.isPackageCall(expr, jsPath, "FuncOf"):
+			return "(...args: any[]) => any"
+		case f.isPackageCall(expr, gojiPath, "Export") && len(expr.Args) == 1:
+			return g.exportType(f, body, expr.Args[0])
+		}
+	}
+	return "any"
+}
+
+// asyncResult returns the TS type of the values resolved by the
+// given func passed to goji.Async, inferred from its return statements.
+func (g *generator) asyncResult(f *file, body *ast.BlockStmt, expr ast.Expr) string {
+	fnFile, fnBody := g.resolveFuncBody(f, body, expr, 0)
+	if fnBody == nil {
+		return "any"
+	}
+	var types []string
+	seen := make(map[string]bool)
+	ast.Inspect(fnBody, func(n ast.Node) bool {
+		switch n := n.(type) {
+		case *ast.FuncLit:
+			// returns of nested funcs are not results
+			return false
+		case *ast.ReturnStmt:
+			if len(n.Results) == 0 {
+				return true
+			}
+			t := g.jsValueType(fnFile, fnBody, body, n.Results[0], 0)
+			if !seen[t] {
+				seen[t] = true
+				types = append(types, t)
+			}
+		}
+		return true
+	})
+	if len(types) == 0 || seen["any"] {
+		return "any"
+	}
+	return strings.Join(types, " | ")
+}
+
+// resolveFuncBody returns the body of the given func expr and the file it is declared in.
+func (g *generator) resolveFuncBody(f *file, body *ast.BlockStmt, expr ast.Expr, depth int) (*file, *ast.BlockStmt) {
+	if depth > 8 {
+		return nil, nil
+	}
+	switch expr := expr.(type) {
+	case *ast.ParenExpr:
+		return g.resolveFuncBody(f, body, expr.X, depth+1)
+
+	case *ast.FuncLit:
+		return f, expr.Body
+
+	case *ast.Ident:
+		if b := g.pkg.lookup(body, expr.Name); b != nil {
+			if b.value != nil {
+				return g.resolveFuncBody(f, body, b.value, depth+1)
+			}
+			return nil, nil
+		}
+		if fn, ok := g.pkg.funcs[expr.Name]; ok {
+			return fn.file, fn.decl.Body
+		}
+	}
+	return nil, nil
+}
+
+// jsValueType returns the TS type of the given js.Value expr returned
+// from a func passed to goji.Async. Identifiers are looked up in the
+// func body first and then in the enclosing body.
+func (g *generator) jsValueType(f *file, fnBody, body *ast.BlockStmt, expr ast.Expr, depth int) string {
+	if depth > 8 {
+		return "any"
+	}
+	switch expr := expr.(type) {
+	case *ast.ParenExpr:
+		return g.jsValueType(f, fnBody, body, expr.X, depth+1)
+
+	case *ast.Ident:
+		b := g.pkg.lookup(fnBody, expr.Name)
+		if b == nil {
+			b = g.pkg.lookup(body, expr.Name)
+		}
+		if b != nil && b.value != nil {
+			return g.jsValueType(f, fnBody, body, b.value, depth+1)
+		}
+
+	case *ast.CallExpr:
+		switch {
+		case f.isPackageCall(expr, jsPath, "Undefined"):
+			return "undefined"
+		case f.isPackageCall(expr, jsPath, "Null"):
+			return "null"
+		case f.isPackageCall(expr, jsPath, "ValueOf") && len(expr.Args) == 1,
+			f.isPackageCall(expr, gojiPath, "MustMarshalJS") && len(expr.Args) == 1:
+			return g.goValueType(f, fnBody, body, expr.Args[0], depth+1)
+		}
+	}
+	return "any"
+}
+
+// goValueType returns the TS type of the given Go value expr as encoded by goji.MarshalJS.
+func (g *generator) goValueType(f *file, fnBody, body *ast.BlockStmt, expr ast.Expr, depth int) string {
+	if depth > 8 {
+		return "any"
+	}
+	switch expr := expr.(type) {
+	case *ast.ParenExpr:
+		return g.goValueType(f, fnBody, body, expr.X, depth+1)
+
+	case *ast.BasicLit:
+		switch expr.Kind {
+		case token.STRING, token.CHAR:
+			return "string"
+		default:
+			return "number"
+		}
+
+	case *ast.CompositeLit:
+		if expr.Type != nil {
+			return g.tsType(f, expr.Type)
+		}
+
+	case *ast.UnaryExpr:
+		if lit, ok := expr.X.(*ast.CompositeLit); ok && expr.Op == token.AND && lit.Type != nil {
+			return g.tsType(f, lit.Type)
+		}
+
+	case *ast.Ident:
+		switch expr.Name {
+		case "true", "false":
+			return "boolean"
+		}
+		b := g.pkg.lookup(fnBody, expr.Name)
+		if b == nil {
+			b = g.pkg.lookup(body, expr.Name)
+		}
+		switch {
+		case b == nil:
+		case b.typ != nil:
+			return g.tsType(f, b.typ)
+		case b.value != nil:
+			return g.goValueType(f, fnBody, body, b.value, depth+1)
+		}
+
+	case *ast.CallExpr:
+		ident, ok := expr.Fun.(*ast.Ident)
+		if !ok {
+			return "any"
+		}
+		fn, ok := g.pkg.funcs[ident.Name]
+		if !ok || fn.decl.Type.Results == nil || len(fn.decl.Type.Results.List) == 0 {
+			return "any"
+		}
+		return g.tsType(fn.file, fn.decl.Type.Results.List[0].Type)
+	}
+	return "any"
+}
+
+// exportType records the given value exported with goji.Export and returns its TS type.
+func (g *generator) exportType(f *file, body *ast.BlockStmt, expr ast.Expr) string {
+	if fn := g.resolveFunc(f, body, expr, 0); fn != nil {
+		params, result := g.signature(fn.file, fn.typ)
+		return fmt.Sprintf("(%s) => %s", params, result)
+	}
+	name, pointer, ok := g.resolveType(f, body, expr, 0)
+	if !ok {
+		return "any"
+	}
+	g.objects[name] = g.objects[name] || pointer
+	g.use(name)
+	return name
+}
+
+// funcType is a func type and the file it is declared in.
+type funcType struct {
+	typ  *ast.FuncType
+	file *file
+}
+
+// resolveFunc returns the func type of the given expr if it is a func.
+func (g *generator) resolveFunc(f *file, body *ast.BlockStmt, expr ast.Expr, depth int) *funcType {
+	if depth > 8 {
+		return nil
+	}
+	switch expr := expr.(type) {
+	case *ast.ParenExpr:
+		return g.resolveFunc(f, body, expr.X, depth+1)
+
+	case *ast.FuncLit:
+		return &funcType{typ: expr.Type, file: f}
+
+	case *ast.Ident:
+		if b := g.pkg.lookup(body, expr.Name); b != nil {
+			if b.value != nil {
+				return g.resolveFunc(f, body, b.value, depth+1)
+			}
+			return nil
+		}
+		if fn, ok := g.pkg.funcs[expr.Name]; ok {
+			return &funcType{typ: fn.decl.Type, file: fn.file}
+		}
+	}
+	return nil
+}
+
+// resolveType returns the name of the package level type of the given
+// expr and true if the expr is a pointer to that type.
+func (g *generator) resolveType(f *file, body *ast.BlockStmt, expr ast.Expr, depth int) (string, bool, bool) {
+	if depth > 8 {
+		return "", false, false
+	}
+	switch expr := expr.(type) {
+	case *ast.ParenExpr:
+		return g.resolveType(f, body, expr.X, depth+1)
+
+	case *ast.UnaryExpr:
+		if expr.Op != token.AND {
+			return "", false, false
+		}
+		name, _, ok := g.resolveType(f, body, expr.X, depth+1)
+		return name, true, ok
+
+	case *ast.CompositeLit:
+		return g.typeName(expr.Type)
+
+	case *ast.CallExpr:
+		ident, ok := expr.Fun.(*ast.Ident)
+		if !ok {
+			return "", false, false
+		}
+		if ident.Name == "new" && len(expr.Args) == 1 {
+			name, _, ok := g.typeName(expr.Args[0])
+			return name, true, ok
+		}
+		fn, ok := g.pkg.funcs[ident.Name]
+		if !ok || fn.decl.Type.Results == nil || len(fn.decl.Type.Results.List) == 0 {
+			return "", false, false
+		}
+		return g.typeName(fn.decl.Type.Results.List[0].Type)
+
+	case *ast.Ident:
+		b := g.pkg.lookup(body, expr.Name)
+		switch {
+		case b == nil:
+			return "", false, false
+		case b.typ != nil:
+			return g.typeName(b.typ)
+		case b.value != nil:
+			return g.resolveType(f, body, b.value, depth+1)
+		}
+	}
+	return "", false, false
+}
+
+// typeName returns the name of the given package level type
+// expr and true if the expr is a pointer to that type.
+func (g *generator) typeName(expr ast.Expr) (string, bool, bool) {
+	pointer := false
+	if star, ok := expr.(*ast.StarExpr); ok {
+		expr = star.X
+		pointer = true
+	}
+	ident, ok := expr.(*ast.Ident)
+	if !ok {
+		return "", false, false
+	}
+	if _, ok := g.pkg.types[ident.Name]; !ok {
+		return "", false, false
+	}
+	return ident.Name, pointer, true
+}
+
+// use queues the given package level type for declaration.
+func (g *generator) use(name string) {
+	if g.declared[name] {
+		return
+	}
+	g.declared[name] = true
+	g.queue = append(g.queue, name)
+}
+
+// renderObject returns the interface declaration of a type exported with goji.Export.
+func (g *generator) renderObject(name string) string {
+	pointer := g.objects[name]
+	decl := g.pkg.types[name]
+
+	var methods []*funcDecl
+	for _, m := range g.pkg.methods[name] {
+		if m.decl.Name.IsExported() && (pointer || !m.pointer) {
+			methods = append(methods, m)
+		}
+	}
+	sort.Slice(methods, func(i, j int) bool {
+		return methods[i].decl.Name.Name < methods[j].decl.Name.Name
+	})
+
+	var buf strings.Builder
+	buf.WriteString(jsDoc(decl.doc, ""))
+	fmt.Fprintf(&buf, "export interface %s {\n", name)
+	for _, m := range methods {
+		params, result := g.signature(m.file, m.decl.Type)
+		buf.WriteString(jsDoc(m.decl.Doc, "\t"))
+		fmt.Fprintf(&buf, "\t%s(%s): %s;\n", exportName(m.decl.Name.Name), params, result)
+	}
+	buf.WriteString("}\n")
+	return buf.String()
+}
+
+// renderData returns the declaration of a type used as a value.
+func (g *generator) renderData(name string) string {
+	decl := g.pkg.types[name]
+
+	var buf strings.Builder
+	buf.WriteString(jsDoc(decl.doc, ""))
+
+	st, ok := decl.spec.Type.(*ast.StructType)
+	if !ok {
+		fmt.Fprintf(&buf, "export type %s = %s;\n", name, g.tsType(decl.file, decl.spec.Type))
+		return buf.String()
+	}
+	var extends []string
+	for _, field := range st.Fields.List {
+		if len(field.Names) > 0 {
+			continue
+		}
+		if embedded, ok := g.embeddedStruct(field); ok {
+			g.use(embedded)
+			extends = append(extends, embedded)
+		}
+	}
+	fmt.Fprintf(&buf, "export interface %s ", name)
+	if len(extends) > 0 {
+		fmt.Fprintf(&buf, "extends %s ", strings.Join(extends, ", "))
+	}
+	buf.WriteString("{\n")
+	buf.WriteString(g.renderFields(decl.file, st, "\t"))
+	buf.WriteString("}\n")
+	return buf.String()
+}
+
+// embeddedStruct returns the name of the given embedded package level
+// struct field if its fields are flattened into the parent struct.
+func (g *generator) embeddedStruct(field *ast.Field) (string, bool) {
+	name, _, ok := g.typeName(field.Type)
+	if !ok || len(field.Names) > 0 || fieldName(field, "") != "" {
+		return "", false
+	}
+	_, ok = g.pkg.types[name].spec.Type.(*ast.StructType)
+	return name, ok
+}
+
+// renderFields returns the property declarations of the given struct.
+func (g *generator) renderFields(f *file, st *ast.StructType, indent string) string {
+	var buf strings.Builder
+	for _, field := range st.Fields.List {
+		if field.Tag != nil && tagValue(field) == "-" {
+			continue
+		}
+		names := field.Names
+		if len(names) == 0 {
+			// embedded structs are declared with extends
+			if _, ok := g.embeddedStruct(field); ok {
+				continue
+			}
+			names = []*ast.Ident{embeddedName(field.Type)}
+		}
+		for _, ident := range names {
+			if ident == nil || !ident.IsExported() {
+				continue
+			}
+			name := fieldName(field, ident.Name)
+			if name == "" {
+				name = ident.Name
+			}
+			optional := ""
+			if strings.Contains(tagValue(field), ",omitempty") {
+				optional = "?"
+			}
+			buf.WriteString(jsDoc(field.Doc, indent))
+			fmt.Fprintf(&buf, "%s%s%s: %s;\n", indent, propertyName(name), optional, g.tsType(f, field.Type))
+		}
+	}
+	return buf.String()
+}
+
+// renderEventMaps returns the event map declarations of the typed event targets.
+func (g *generator) renderEventMaps() []string {
+	var res []string
+	for _, name := range sortedKeys(g.targets) {
+		target := g.targets[name]
+		if len(target.events) == 0 {
+			continue
+		}
+		detail := g.tsType(target.file, target.detail)
+
+		var buf strings.Builder
+		fmt.Fprintf(&buf, "export interface %sEventMap {\n", target.name)
+		for _, eventType := range sortedKeys(target.events) {
+			fmt.Fprintf(&buf, "\t%s: CustomEvent<%s>;\n", strconv.Quote(eventType), detail)
+		}
+		buf.WriteString("}\n")
+		res = append(res, buf.String())
+	}
+	return res
+}
+
+// signature returns the TS parameters and result type of the given exported func.
+//
+// Funcs that take a context.Context or return an error return a
+// promise and accept an optional AbortSignal as their last parameter.
+func (g *generator) signature(f *file, ft *ast.FuncType) (string, string) {
+	var params []string
+	var fields []*ast.Field
+	if ft.Params != nil {
+		fields = ft.Params.List
+	}
+	takesContext := len(fields) > 0 && f.isPackageSelector(fields[0].Type, "context", "Context")
+	if takesContext {
+		if len(fields[0].Names) > 1 {
+			fields = append([]*ast.Field{{Names: fields[0].Names[1:], Type: fields[0].Type}}, fields[1:]...)
+		} else {
+			fields = fields[1:]
+		}
+	}
+	variadic := false
+	for _, field := range fields {
+		names := field.Names
+		if len(names) == 0 {
+			names = []*ast.Ident{nil}
+		}
+		for _, ident := range names {
+			name := fmt.Sprintf("arg%d", len(params))
+			if ident != nil && ident.Name != "_" {
+				name = ident.Name
+			}
+			if ellipsis, ok := field.Type.(*ast.Ellipsis); ok {
+				variadic = true
+				params = append(params, fmt.Sprintf("...%s: %s", name, arrayType(g.tsType(f, ellipsis.Elt))))
+			} else {
+				params = append(params, fmt.Sprintf("%s: %s", name, g.tsType(f, field.Type)))
+			}
+		}
+	}
+
+	var results []string
+	returnsError := false
+	if ft.Results != nil {
+		for _, field := range ft.Results.List {
+			count := max(len(field.Names), 1)
+			for i := 0; i < count; i++ {
+				results = append(results, g.tsType(f, field.Type))
+			}
+		}
+		last := ft.Results.List[len(ft.Results.List)-1]
+		if ident, ok := last.Type.(*ast.Ident); ok && ident.Name == "error" {
+			returnsError = true
+			results = results[:len(results)-1]
+		}
+	}
+
+	var result string
+	switch len(results) {
+	case 0:
+		result = "void"
+	case 1:
+		result = results[0]
+	default:
+		result = "[" + strings.Join(results, ", ") + "]"
+	}
+	if takesContext && !variadic {
+		params = append(params, "signal?: AbortSignal")
+	}
+	if takesContext || returnsError {
+		result = "Promise<" + result + ">"
+	}
+	return strings.Join(params, ", "), result
+}
+
+// tsType returns the TS type of the given Go type expr as encoded by goji.MarshalJS.
+//
+// Nil pointers and goji.Nullable values are typed as null, but nil slices
+// and maps are not, to keep the declarations readable for the common case.
+func (g *generator) tsType(f *file, expr ast.Expr) string {
+	switch expr := expr.(type) {
+	case *ast.ParenExpr:
+		return g.tsType(f, expr.X)
+
+	case *ast.Ident:
+		switch expr.Name {
+		case "string":
+			return "string"
+		case "bool":
+			return "boolean"
+		case "int", "int8", "int16", "int32", "rune",
+			"uint", "uint8", "uint16", "uint32", "byte", "uintptr",
+			"float32", "float64":
+			return "number"
+		case "int64", "uint64":
+			return "bigint"
+		case "error":
+			return "Error"
+		case "any":
+			return "any"
+		}
+		decl, ok := g.pkg.types[expr.Name]
+		if !ok || decl.spec.TypeParams != nil {
+			return "any"
+		}
+		g.use(expr.Name)
+		return expr.Name
+
+	case *ast.StarExpr:
+		return g.tsType(f, expr.X) + " | null"
+
+	case *ast.IndexExpr:
+		if f.isPackageSelector(expr.X, gojiPath, "Nullable") {
+			return g.tsType(f, expr.Index) + " | null"
+		}
+		return "any"
+
+	case *ast.ArrayType:
+		if ident, ok := expr.Elt.(*ast.Ident); ok && expr.Len == nil && (ident.Name == "byte" || ident.Name == "uint8") {
+			return "Uint8Array"
+		}
+		return arrayType(g.tsType(f, expr.Elt))
+
+	case *ast.MapType:
+		value := g.tsType(f, expr.Value)
+		if ident, ok := expr.Key.(*ast.Ident); ok && ident.Name == "string" {
+			return "Record<string, " + value + ">"
+		}
+		return "Map<" + g.tsType(f, expr.Key) + ", " + value + ">"
+
+	case *ast.SelectorExpr:
+		switch {
+		case f.isPackageSelector(expr, "time", "Time"):
+			return "Date"
+		case f.isPackageSelector(expr, "time", "Duration"), f.isPackageSelector(expr, "math/big", "Int"):
+			return "bigint"
+		case f.isPackageSelector(expr, jsPath, "Func"):
+			return "Function"
+		}
+		return "any"
+
+	case *ast.StructType:
+		return "{\n" + g.renderFields(f, expr, "\t\t") + "\t}"
+	}
+	return "any"
+}
+
+// arrayType returns the TS array type of the given element type.
+func arrayType(elem string) string {
+	if strings.ContainsAny(elem, " |") {
+		return "(" + elem + ")[]"
+	}
+	return elem + "[]"
+}
+
+// tagValue returns the js tag of the given field, falling back to the json tag.
+func tagValue(field *ast.Field) string {
+	if field.Tag == nil {
+		return ""
+	}
+	tag, err := strconv.Unquote(field.Tag.Value)
+	if err != nil {
+		return ""
+	}
+	if value, ok := reflect.StructTag(tag).Lookup("js"); ok {
+		return value
+	}
+	return reflect.StructTag(tag).Get("json")
+}
+
+// fieldName returns the tagged name of the given field or
+// the given default name if the field has no tagged name.
+func fieldName(field *ast.Field, name string) string {
+	tagged, _, _ := strings.Cut(tagValue(field), ",")
+	if tagged != "" {
+		return tagged
+	}
+	return name
+}
+
+// embeddedName returns the field name of the given embedded type.
+func embeddedName(expr ast.Expr) *ast.Ident {
+	switch t := expr.(type) {
+	case *ast.StarExpr:
+		return embeddedName(t.X)
+	case *ast.SelectorExpr:
+		return t.Sel
+	case *ast.Ident:
+		return t
+	}
+	return nil
+}
+
+// jsDoc returns the given Go doc comment as a JSDoc comment.
+func jsDoc(doc *ast.CommentGroup, indent string) string {
+	text := strings.TrimSpace(doc.Text())
+	if text == "" {
+		return ""
+	}
+	lines := strings.Split(text, "\n")
+	if len(lines) == 1 {
+		return indent + "/** " + lines[0] + " */\n"
+	}
+	var buf strings.Builder
+	buf.WriteString(indent + "/**\n")
+	for _, line := range lines {
+		buf.WriteString(strings.TrimRight(indent+" * "+line, " ") + "\n")
+	}
+	buf.WriteString(indent + " */\n")
+	return buf.String()
+}
+
+// propertyName returns the given name quoted if it is not a valid TS identifier.
+func propertyName(name string) string {
+	for i, r := range name {
+		if r == '_' || r == '$' || unicode.IsLetter(r) || (i > 0 && unicode.IsDigit(r)) {
+			continue
+		}
+		return strconv.Quote(name)
+	}
+	return name
+}
+
+// exportName returns the lowerCamelCase JS name of the given Go name
+// using the same rules as goji.Export.
+func exportName(name string) string {
+	runes := []rune(name)
+	n := 0
+	for n < len(runes) && unicode.IsUpper(runes[n]) {
+		n++
+	}
+	if n > 1 && n < len(runes) && unicode.IsLower(runes[n]) {
+		n--
+	}
+	for i := 0; i < n; i++ {
+		runes[i] = unicode.ToLower(runes[i])
+	}
+	return string(runes)
+}
+
+// upperFirst returns the given name with its first letter in upper case.
+func upperFirst(name string) string {
+	runes := []rune(name)
+	if len(runes) > 0 {
+		runes[0] = unicode.ToUpper(runes[0])
+	}
+	return string(runes)
+}
+
+// exprName returns the name of the variable or field selected by the given expr.
+func exprName(expr ast.Expr) string {
+	switch expr := expr.(type) {
+	case *ast.Ident:
+		return expr.Name
+	case *ast.SelectorExpr:
+		return expr.Sel.Name
+	}
+	return ""
+}
+
+// stringLit returns the value of the given string literal.
+func stringLit(expr ast.Expr) (string, bool) {
+	lit, ok := expr.(*ast.BasicLit)
+	if !ok || lit.Kind != token.STRING {
+		return "", false
+	}
+	value, err := strconv.Unquote(lit.Value)
+	return value, err == nil
+}
+
+// sortedKeys returns the keys of the given map in sorted order.
+func sortedKeys[V any](m map[string]V) []string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
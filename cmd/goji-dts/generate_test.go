@@ -0,0 +1,53 @@
+//go:build !js
+
+package main
+
+import (
+	"flag"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var update = flag.Bool("update", false, "update the golden files")
+
+func TestGenerate(t *testing.T) {
+	dirs, err := filepath.Glob(filepath.Join("testdata", "*"))
+	require.NoError(t, err)
+
+	for _, dir := range dirs {
+		info, err := os.Stat(dir)
+		require.NoError(t, err)
+		if !info.IsDir() {
+			continue
+		}
+		t.Run(filepath.Base(dir), func(t *testing.T) {
+			pkg, err := parsePackage(dir)
+			require.NoError(t, err)
+
+			res := generate(pkg)
+			golden := dir + ".d.ts"
+			if *update {
+				require.NoError(t, os.WriteFile(golden, res, 0o644))
+			}
+			expected, err := os.ReadFile(golden)
+			require.NoError(t, err)
+			assert.Equal(t, string(expected), string(res))
+		})
+	}
+}
+
+func TestExportName(t *testing.T) {
+	assert.Equal(t, "getUser", exportName("GetUser"))
+	assert.Equal(t, "id", exportName("ID"))
+	assert.Equal(t, "httpClient", exportName("HTTPClient"))
+}
+
+func TestPropertyName(t *testing.T) {
+	assert.Equal(t, "name", propertyName("name"))
+	assert.Equal(t, `"content-type"`, propertyName("content-type"))
+	assert.Equal(t, `"1st"`, propertyName("1st"))
+}
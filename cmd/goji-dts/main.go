@@ -0,0 +1,66 @@
+//go:build !js
+
+// Command goji-dts generates TypeScript declarations for
+// the JS values exported by a Go package that uses goji.
+//
+// The package source is inspected without type checking, so the
+// command runs on any platform regardless of the package build tags.
+// The generated declarations contain:
+//
+//   - a global variable for each value exported with goji.ExportGlobal
+//     or set on the global object with js.Global().Set
+//   - a Promise<T> result for each func built with goji.Async, where T is
+//     inferred from the values it returns with js.ValueOf, goji.MustMarshalJS,
+//     js.Null or js.Undefined, and is any when a value cannot be inferred
+//   - an interface for each Go type exported with goji.Export
+//   - an interface for each struct used by the exported values,
+//     with properties named by their js or json tags
+//   - an event map for each goji.TypedEventTarget whose events are
+//     emitted or listened for with a string literal event type, named
+//     after its variable, or after the tag or struct type of its field
+//
+// Usage:
+//
+//	goji-dts [-o output] [dir]
+//
+// The declarations are written to stdout unless an output file is given.
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+)
+
+func main() {
+	output := flag.String("o", "", "output file")
+	flag.Usage = func() {
+		fmt.Fprintf(flag.CommandLine.Output(), "usage: goji-dts [-o output] [dir]\n")
+		flag.PrintDefaults()
+	}
+	flag.Parse()
+
+	dir := "."
+	if flag.NArg() > 0 {
+		dir = flag.Arg(0)
+	}
+	if err := run(dir, *output); err != nil {
+		fmt.Fprintf(os.Stderr, "goji-dts: %v\n", err)
+		os.Exit(1)
+	}
+}
+
+// run generates the declarations for the package in the
+// given dir and writes them to the given output file.
+func run(dir, output string) error {
+	pkg, err := parsePackage(dir)
+	if err != nil {
+		return err
+	}
+	res := generate(pkg)
+	if output == "" {
+		_, err = os.Stdout.Write(res)
+		return err
+	}
+	return os.WriteFile(output, res, 0o644)
+}
@@ -0,0 +1,235 @@
+//go:build !js
+
+package main
+
+import (
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"os"
+	"path"
+	"path/filepath"
+	"strconv"
+	"strings"
+)
+
+const (
+	// gojiPath is the import path of the goji package.
+	gojiPath = "github.com/sourcenetwork/goji"
+	// jsPath is the import path of the syscall/js package.
+	jsPath = "syscall/js"
+)
+
+// pkg contains the declarations of a parsed package.
+type pkg struct {
+	name  string
+	files []*file
+	// types contains the package level type declarations by name.
+	types map[string]*typeDecl
+	// funcs contains the package level func declarations by name.
+	funcs map[string]*funcDecl
+	// methods contains the method declarations by receiver type name.
+	methods map[string][]*funcDecl
+	// vars contains the package level var declarations by name.
+	vars map[string]*binding
+}
+
+// file is a parsed source file.
+type file struct {
+	ast *ast.File
+	// imports contains the import paths by local name.
+	imports map[string]string
+}
+
+// typeDecl is a package level type declaration.
+type typeDecl struct {
+	spec *ast.TypeSpec
+	doc  *ast.CommentGroup
+	file *file
+}
+
+// funcDecl is a package level func or method declaration.
+type funcDecl struct {
+	decl *ast.FuncDecl
+	file *file
+	// pointer is true if the method has a pointer receiver.
+	pointer bool
+}
+
+// binding is the declared type or initial value of a variable.
+type binding struct {
+	typ   ast.Expr
+	value ast.Expr
+}
+
+// parsePackage parses the non test Go files in the given dir.
+//
+// Build constraints are ignored so that packages which
+// only build for js can be parsed on any platform.
+func parsePackage(dir string) (*pkg, error) {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, err
+	}
+	p := &pkg{
+		types:   make(map[string]*typeDecl),
+		funcs:   make(map[string]*funcDecl),
+		methods: make(map[string][]*funcDecl),
+		vars:    make(map[string]*binding),
+	}
+	fset := token.NewFileSet()
+	for _, entry := range entries {
+		name := entry.Name()
+		if entry.IsDir() || !strings.HasSuffix(name, ".go") || strings.HasSuffix(name, "_test.go") {
+			continue
+		}
+		f, err := parser.ParseFile(fset, filepath.Join(dir, name), nil, parser.ParseComments)
+		if err != nil {
+			return nil, err
+		}
+		if p.name == "" {
+			p.name = f.Name.Name
+		}
+		if f.Name.Name != p.name {
+			continue
+		}
+		p.addFile(f)
+	}
+	if p.name == "" {
+		return nil, fmt.Errorf("no Go files in %s", dir)
+	}
+	return p, nil
+}
+
+// addFile adds the declarations of the given file to the package.
+func (p *pkg) addFile(f *ast.File) {
+	fi := &file{ast: f, imports: make(map[string]string)}
+	for _, spec := range f.Imports {
+		importPath, _ := strconv.Unquote(spec.Path.Value)
+		name := path.Base(importPath)
+		if spec.Name != nil {
+			name = spec.Name.Name
+		}
+		fi.imports[name] = importPath
+	}
+	p.files = append(p.files, fi)
+
+	for _, decl := range f.Decls {
+		switch decl := decl.(type) {
+		case *ast.FuncDecl:
+			if decl.Recv == nil {
+				p.funcs[decl.Name.Name] = &funcDecl{decl: decl, file: fi}
+				continue
+			}
+			name, pointer := receiverName(decl.Recv.List[0].Type)
+			p.methods[name] = append(p.methods[name], &funcDecl{decl: decl, file: fi, pointer: pointer})
+
+		case *ast.GenDecl:
+			for _, spec := range decl.Specs {
+				switch spec := spec.(type) {
+				case *ast.TypeSpec:
+					doc := spec.Doc
+					if doc == nil && len(decl.Specs) == 1 {
+						doc = decl.Doc
+					}
+					p.types[spec.Name.Name] = &typeDecl{spec: spec, doc: doc, file: fi}
+
+				case *ast.ValueSpec:
+					if decl.Tok != token.VAR {
+						continue
+					}
+					for i, name := range spec.Names {
+						b := &binding{typ: spec.Type}
+						if i < len(spec.Values) {
+							b.value = spec.Values[i]
+						}
+						p.vars[name.Name] = b
+					}
+				}
+			}
+		}
+	}
+}
+
+// receiverName returns the base type name of the given receiver type
+// and true if the receiver is a pointer.
+func receiverName(expr ast.Expr) (string, bool) {
+	pointer := false
+	if star, ok := expr.(*ast.StarExpr); ok {
+		expr = star.X
+		pointer = true
+	}
+	switch t := expr.(type) {
+	case *ast.IndexExpr:
+		expr = t.X
+	case *ast.IndexListExpr:
+		expr = t.X
+	}
+	if ident, ok := expr.(*ast.Ident); ok {
+		return ident.Name, pointer
+	}
+	return "", pointer
+}
+
+// isPackageSelector returns true if the given expr selects the
+// given name from the package with the given import path.
+func (f *file) isPackageSelector(expr ast.Expr, importPath, name string) bool {
+	sel, ok := expr.(*ast.SelectorExpr)
+	if !ok || sel.Sel.Name != name {
+		return false
+	}
+	ident, ok := sel.X.(*ast.Ident)
+	return ok && f.imports[ident.Name] == importPath
+}
+
+// isPackageCall returns true if the given call is a call to one of
+// the funcs with the given names in the package with the given import path.
+func (f *file) isPackageCall(call *ast.CallExpr, importPath string, names ...string) bool {
+	for _, name := range names {
+		if f.isPackageSelector(call.Fun, importPath, name) {
+			return true
+		}
+	}
+	return false
+}
+
+// lookup returns the binding of the variable with the given name
+// declared in the given func body or at the package level.
+func (p *pkg) lookup(body *ast.BlockStmt, name string) *binding {
+	var res *binding
+	if body != nil {
+		ast.Inspect(body, func(n ast.Node) bool {
+			if res != nil {
+				return false
+			}
+			switch n := n.(type) {
+			case *ast.AssignStmt:
+				if len(n.Lhs) != len(n.Rhs) {
+					return true
+				}
+				for i, lhs := range n.Lhs {
+					if ident, ok := lhs.(*ast.Ident); ok && ident.Name == name {
+						res = &binding{value: n.Rhs[i]}
+					}
+				}
+
+			case *ast.ValueSpec:
+				for i, ident := range n.Names {
+					if ident.Name != name {
+						continue
+					}
+					res = &binding{typ: n.Type}
+					if i < len(n.Values) {
+						res.value = n.Values[i]
+					}
+				}
+			}
+			return true
+		})
+	}
+	if res != nil {
+		return res
+	}
+	return p.vars[name]
+}
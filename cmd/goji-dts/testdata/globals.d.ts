@@ -0,0 +1,18 @@
+// Code generated by goji-dts. DO NOT EDIT.
+
+/** Data is the result of fetchData. */
+export interface Data {
+	id: string;
+	items: string[];
+}
+
+declare global {
+	var add: (a: number, b: number) => number;
+	var fetchData: (...args: any[]) => Promise<Data>;
+	var greet: (name: string) => Promise<string>;
+	var load: (...args: any[]) => Promise<null | string>;
+	var log: (...args: any[]) => any;
+	var ping: (...args: any[]) => Promise<undefined>;
+	var raw: (...args: any[]) => Promise<any>;
+	var version: string;
+}
@@ -0,0 +1,49 @@
+//go:build js
+
+package main
+
+import (
+	"syscall/js"
+
+	"github.com/sourcenetwork/goji"
+)
+
+// Data is the result of fetchData.
+type Data struct {
+	ID    string   `json:"id"`
+	Items []string `json:"items"`
+}
+
+func add(a, b int) int {
+	return a + b
+}
+
+func load(this js.Value, args []js.Value) (js.Value, error) {
+	if len(args) == 0 {
+		return js.Null(), nil
+	}
+	return js.ValueOf("loaded"), nil
+}
+
+func main() {
+	fetch := goji.Async(func(this js.Value, args []js.Value) (js.Value, error) {
+		data := Data{ID: args[0].String()}
+		return goji.MustMarshalJS(data), nil
+	})
+	js.Global().Set("fetchData", fetch)
+	js.Global().Set("load", goji.Async(load))
+	js.Global().Set("ping", goji.Async(func(this js.Value, args []js.Value) (js.Value, error) {
+		return js.Undefined(), nil
+	}))
+	js.Global().Set("raw", goji.Async(func(this js.Value, args []js.Value) (js.Value, error) {
+		return args[0], nil
+	}))
+	js.Global().Set("version", "1.0.0")
+	js.Global().Set("log", js.FuncOf(func(this js.Value, args []js.Value) any {
+		return nil
+	}))
+	goji.ExportGlobal("add", add)
+	goji.ExportGlobal("greet", func(name string) (string, error) {
+		return "hello " + name, nil
+	})
+}
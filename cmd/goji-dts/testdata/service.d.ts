@@ -0,0 +1,56 @@
+// Code generated by goji-dts. DO NOT EDIT.
+
+/** Base contains the fields shared by all records. */
+export interface Base {
+	version: bigint;
+}
+
+export interface Message {
+	from: User;
+	text: string;
+}
+
+export interface Profile {
+	bio: string;
+	links: Record<string, string>;
+}
+
+export type Role = string;
+
+/** Service manages users. */
+export interface Service {
+	/** AddUser adds the given user. */
+	addUser(user: User): void;
+	close(): void;
+	count(): number;
+	delete(...ids: string[]): Promise<void>;
+	/** GetUser returns the user with the given id. */
+	getUser(id: string, signal?: AbortSignal): Promise<User>;
+	roles(id: string): Role[];
+	send(msg: Message): void;
+}
+
+/** User is a registered user. */
+export interface User extends Base {
+	/** ID is the unique id of the user. */
+	id: string;
+	displayName: string;
+	email?: string;
+	created: Date;
+	tags: string[];
+	profile: Profile | null;
+	age: number | null;
+}
+
+export interface UserAlertsEventMap {
+	"added": CustomEvent<string>;
+}
+
+export interface ServiceEventMap {
+	"close": CustomEvent<Message>;
+	"message": CustomEvent<Message>;
+}
+
+declare global {
+	var userService: Service;
+}
@@ -0,0 +1,96 @@
+//go:build js
+
+package service
+
+import (
+	"context"
+	"time"
+
+	"github.com/sourcenetwork/goji"
+)
+
+// User is a registered user.
+type User struct {
+	// ID is the unique id of the user.
+	ID      string             `json:"id"`
+	Name    string             `js:"displayName" json:"name"`
+	Email   string             `json:"email,omitempty"`
+	Created time.Time          `json:"created"`
+	Tags    []string           `json:"tags"`
+	Profile *Profile           `json:"profile"`
+	Age     goji.Nullable[int] `json:"age"`
+	Base
+	password string
+	Secret   string `json:"-"`
+}
+
+// Base contains the fields shared by all records.
+type Base struct {
+	Version int64 `json:"version"`
+}
+
+type Profile struct {
+	Bio   string            `json:"bio"`
+	Links map[string]string `json:"links"`
+}
+
+type Role string
+
+type Message struct {
+	From User   `json:"from"`
+	Text string `json:"text"`
+}
+
+// Service manages users.
+type Service struct {
+	users  map[string]User
+	events goji.TypedEventTarget[Message]
+	alerts goji.TypedEventTarget[string] `json:"userAlerts"`
+}
+
+// NewService returns a new service.
+func NewService() *Service {
+	return &Service{
+		users:  make(map[string]User),
+		events: goji.NewTypedEventTarget[Message](),
+		alerts: goji.NewTypedEventTarget[string](),
+	}
+}
+
+// AddUser adds the given user.
+func (s *Service) AddUser(user User) {
+	s.users[user.ID] = user
+	s.alerts.Emit("added", user.ID)
+}
+
+// GetUser returns the user with the given id.
+func (s *Service) GetUser(ctx context.Context, id string) (User, error) {
+	return s.users[id], nil
+}
+
+func (s *Service) Roles(id string) []Role {
+	return nil
+}
+
+func (s *Service) Delete(ids ...string) error {
+	return nil
+}
+
+func (s Service) Count() int {
+	return len(s.users)
+}
+
+func (s *Service) Send(msg Message) {
+	s.events.Emit("message", msg)
+}
+
+func (s *Service) Close() {
+	s.events.Emit("close", Message{})
+}
+
+func (s *Service) internal() {}
+
+func main() {
+	svc := NewService()
+	goji.ExportGlobal("userService", svc)
+}
@@ -0,0 +1,893 @@
+//go:build !js
+
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"go/format"
+	"sort"
+	"strings"
+	"unicode"
+)
+
+// gojiPackage is the name of the goji package.
+const gojiPackage = "goji"
+
+// gojiTypes contains the WebIDL interfaces that have wrappers in the goji package.
+var gojiTypes = map[string]bool{
+	"AbortController":       true,
+	"AbortSignal":           true,
+	"ArrayBuffer":           true,
+	"CloseEvent":            true,
+	"CustomEvent":           true,
+	"DataView":              true,
+	"DOMException":          true,
+	"ErrorEvent":            true,
+	"Event":                 true,
+	"EventTarget":           true,
+	"MessageEvent":          true,
+	"ProgressEvent":         true,
+	"Promise":               true,
+	"PromiseRejectionEvent": true,
+	"SharedArrayBuffer":     true,
+	"Uint8Array":            true,
+}
+
+// config contains the generator options.
+type config struct {
+	// pkg is the name of the generated Go package.
+	pkg string
+	// trimPrefix is removed from the start of the generated Go type names.
+	trimPrefix string
+	// mdn is the base URL of the MDN documentation.
+	mdn string
+	// sources contains the names of the WebIDL source files.
+	sources []string
+}
+
+// typeKind is the kind of a Go type.
+type typeKind int
+
+const (
+	voidKind typeKind = iota
+	boolKind
+	intKind
+	floatKind
+	stringKind
+	valueKind
+	wrapperKind
+)
+
+// goType is the Go type of a WebIDL type.
+type goType struct {
+	name string
+	kind typeKind
+	// nullable is true if the type is a nullable primitive or wrapper.
+	nullable bool
+	// qualifier is the prefix of the goji identifiers used by nullable types.
+	qualifier string
+}
+
+// String returns the Go type expression.
+func (t goType) String() string {
+	if t.nullable {
+		return t.qualifier + "Nullable[" + t.name + "]"
+	}
+	return t.name
+}
+
+// generator contains the state used to generate wrappers.
+type generator struct {
+	defs *definitions
+	cfg  config
+	buf  bytes.Buffer
+	// usesGoji is true if the goji package must be imported.
+	usesGoji bool
+	// emitted contains the dictionaries that have been generated.
+	emitted map[string]bool
+	// links contains the MDN link of the first use of each dictionary.
+	links map[string]string
+}
+
+// generate returns the Go wrappers for the given definitions.
+func generate(defs *definitions, cfg config) ([]byte, error) {
+	g := &generator{
+		defs:    defs,
+		cfg:     cfg,
+		emitted: make(map[string]bool),
+		links:   make(map[string]string),
+	}
+	g.includeMixins()
+
+	var interfaces []*idlInterface
+	for _, iface := range defs.interfaces {
+		if !iface.mixin {
+			interfaces = append(interfaces, iface)
+		}
+	}
+	for _, iface := range interfaces {
+		g.collectLinks(iface)
+	}
+
+	g.constants(interfaces)
+	g.init(interfaces)
+	for _, iface := range interfaces {
+		g.iface(iface)
+	}
+	for _, dict := range defs.dictionaries {
+		g.dictionary(dict)
+	}
+
+	var out bytes.Buffer
+	fmt.Fprintf(&out, "// Code generated by gojigen from %s. DO NOT EDIT.\n\n", strings.Join(cfg.sources, ", "))
+	out.WriteString("//go:build js\n\n")
+	fmt.Fprintf(&out, "package %s\n\n", cfg.pkg)
+	if g.usesGoji {
+		out.WriteString("import (\n\t\"syscall/js\"\n\n\t\"github.com/sourcenetwork/goji\"\n)\n")
+	} else {
+		out.WriteString("import \"syscall/js\"\n")
+	}
+	out.Write(g.buf.Bytes())
+	return format.Source(out.Bytes())
+}
+
+// includeMixins adds the members of included mixins to their interfaces.
+func (g *generator) includeMixins() {
+	for _, iface := range g.defs.interfaces {
+		for _, name := range g.defs.includes[iface.name] {
+			if mixin := g.defs.lookupInterface(name); mixin != nil {
+				iface.members = append(iface.members, mixin.members...)
+			}
+		}
+	}
+}
+
+// collectLinks records the MDN link of the dictionaries used by the given interface.
+func (g *generator) collectLinks(iface *idlInterface) {
+	for _, m := range iface.members {
+		var link string
+		switch m.kind {
+		case constructorMember:
+			link = g.link(iface.name, iface.name)
+		case operationMember:
+			link = g.memberLink(iface.name, m)
+		default:
+			continue
+		}
+		if dict := g.optionsDictionary(m.args); dict != nil {
+			if _, ok := g.links[dict.name]; !ok {
+				g.links[dict.name] = link
+			}
+		}
+	}
+}
+
+func (g *generator) printf(format string, args ...any) {
+	fmt.Fprintf(&g.buf, format, args...)
+}
+
+// constants generates the enum values and interface constants.
+func (g *generator) constants(interfaces []*idlInterface) {
+	var lines []string
+	for _, e := range g.defs.enums {
+		for _, value := range e.values {
+			name := g.typeName(e.name) + enumValueName(value)
+			lines = append(lines,
+				fmt.Sprintf("// %s is the %q %s value.", name, value, e.name),
+				fmt.Sprintf("%s = %q", name, value))
+		}
+	}
+	for _, iface := range interfaces {
+		for _, m := range iface.members {
+			if m.kind != constantMember {
+				continue
+			}
+			name := g.typeName(iface.name) + constantName(m.name)
+			lines = append(lines,
+				fmt.Sprintf("// %s is the %s %s constant.", name, iface.name, m.name),
+				fmt.Sprintf("%s = %s", name, m.value))
+		}
+	}
+	if len(lines) == 0 {
+		return
+	}
+	g.printf("\nconst (\n")
+	for _, line := range lines {
+		g.printf("\t%s\n", line)
+	}
+	g.printf(")\n")
+}
+
+// init generates the init func that sets the global interface values.
+func (g *generator) init(interfaces []*idlInterface) {
+	var lines []string
+	for _, iface := range interfaces {
+		if hasGlobal(iface) {
+			name := g.typeName(iface.name)
+			lines = append(lines, fmt.Sprintf("%s = %sJS(js.Global().Get(%q))", name, lowerCamel(name), iface.name))
+		}
+	}
+	if len(lines) == 0 {
+		return
+	}
+	g.printf("\nfunc init() {\n")
+	for _, line := range lines {
+		g.printf("\t%s\n", line)
+	}
+	g.printf("}\n")
+}
+
+// hasGlobal returns true if the interface has a
+// constructor or static members that need a global value.
+func hasGlobal(iface *idlInterface) bool {
+	for _, m := range iface.members {
+		if m.kind == constructorMember || m.static {
+			return true
+		}
+	}
+	return false
+}
+
+// iface generates the wrappers of the given interface.
+func (g *generator) iface(iface *idlInterface) {
+	name := g.typeName(iface.name)
+	recv := g.receiver(iface)
+
+	var ctor *member
+	var statics, attributes, operations []*member
+	seen := make(map[string]bool)
+	for _, m := range iface.members {
+		switch {
+		case m.kind == constructorMember:
+			if ctor == nil {
+				ctor = m
+			}
+		case m.kind == constantMember:
+		case seen[m.name]:
+			// only the first overload is generated
+		case m.static:
+			seen[m.name] = true
+			statics = append(statics, m)
+		case m.kind == attributeMember:
+			seen[m.name] = true
+			attributes = append(attributes, m)
+		case m.kind == operationMember:
+			seen[m.name] = true
+			operations = append(operations, m)
+		}
+	}
+	sortMembers(statics)
+	sortMembers(attributes)
+	sortMembers(operations)
+
+	if hasGlobal(iface) {
+		jsType := lowerCamel(name) + "JS"
+		g.printf("\ntype %s js.Value\n\n", jsType)
+		g.printf("// %s is a wrapper for the %s global interface.\n//\n// %s\n", name, iface.name, g.link(iface.name, ""))
+		g.printf("var %s %s\n", name, jsType)
+
+		if ctor != nil {
+			g.printf("\n// New wraps the %s constructor.\n//\n// %s\n", iface.name, g.link(iface.name, iface.name))
+			g.operation(recv, jsType, "New", "", ctor.args, &idlType{name: iface.name})
+		}
+		for _, m := range statics {
+			if m.kind == attributeMember {
+				g.printf("\n// %s returns the %s %s static property.\n//\n// %s\n", memberName(m.name), iface.name, m.name, g.memberLink(iface.name, m))
+				g.doc(m)
+				g.getter(recv, jsType, m)
+				continue
+			}
+			g.printf("\n// %s wraps the %s %s static method.\n//\n// %s\n", memberName(m.name), iface.name, m.name, g.memberLink(iface.name, m))
+			g.doc(m)
+			g.operation(recv, jsType, memberName(m.name), m.name, m.args, m.typ)
+		}
+	}
+
+	valueType := name + "Value"
+	g.printf("\n// %s is an instance of %s.\ntype %s js.Value\n", valueType, iface.name, valueType)
+	for _, m := range attributes {
+		link := g.memberLink(iface.name, m)
+		g.printf("\n// %s returns the %s %s property.\n//\n// %s\n", memberName(m.name), iface.name, m.name, link)
+		g.doc(m)
+		g.getter(recv, valueType, m)
+		if !m.readonly {
+			g.printf("\n// Set%s sets the %s %s property.\n//\n// %s\n", memberName(m.name), iface.name, m.name, link)
+			g.setter(recv, valueType, m)
+		}
+	}
+	for _, m := range operations {
+		g.printf("\n// %s wraps the %s %s instance method.\n//\n// %s\n", memberName(m.name), iface.name, m.name, g.memberLink(iface.name, m))
+		g.doc(m)
+		g.operation(recv, valueType, memberName(m.name), m.name, m.args, m.typ)
+	}
+	if iface.parent != "" {
+		if parent := g.goType(&idlType{name: iface.parent}); parent.kind == wrapperKind {
+			method := g.typeName(iface.parent)
+			g.printf("\n// %s returns the parent %s.\n", method, iface.parent)
+			g.printf("func (%s %s) %s() %s {\n\treturn %s(%s)\n}\n", recv, valueType, method, parent, parent, recv)
+		}
+	}
+
+	// the options are generated after the first interface that uses them
+	for _, m := range iface.members {
+		if m.kind != constructorMember && m.kind != operationMember {
+			continue
+		}
+		if dict := g.optionsDictionary(m.args); dict != nil {
+			g.dictionary(dict)
+		}
+	}
+}
+
+// receiver returns the receiver name of the given interface, which is
+// shared with the interfaces it inherits from in the generated package
+// so that the wrappers of an interface family read the same.
+func (g *generator) receiver(iface *idlInterface) string {
+	root := iface.name
+	for parent := iface.parent; parent != ""; {
+		if !g.isInterface(parent) && !(gojiTypes[parent] && g.cfg.pkg == gojiPackage) {
+			break
+		}
+		root = parent
+		if p := g.defs.lookupInterface(parent); p != nil {
+			parent = p.parent
+		} else {
+			parent = ""
+		}
+	}
+	return receiverName(g.typeName(root))
+}
+
+// sortMembers sorts the given members by their Go name.
+func sortMembers(members []*member) {
+	sort.SliceStable(members, func(i, j int) bool {
+		return memberName(members[i].name) < memberName(members[j].name)
+	})
+}
+
+// doc generates the paragraph written above the given member in the WebIDL source.
+func (g *generator) doc(m *member) {
+	if len(m.doc) == 0 {
+		return
+	}
+	g.printf("//\n")
+	for _, line := range m.doc {
+		g.printf("// %s\n", line)
+	}
+}
+
+// getter generates the getter of the given attribute.
+func (g *generator) getter(recv, typ string, m *member) {
+	t := g.resultType(m.typ, m.nullable)
+	g.printf("func (%s %s) %s() %s {\n", recv, typ, memberName(m.name), t)
+	g.result(fmt.Sprintf("js.Value(%s).Get(%q)", recv, m.name), t, "\t")
+	g.printf("}\n")
+}
+
+// setter generates the setter of the given attribute.
+func (g *generator) setter(recv, typ string, m *member) {
+	t := g.goType(m.typ)
+	g.printf("func (%s %s) Set%s(value %s) {\n", recv, typ, memberName(m.name), t)
+	g.set("\t", fmt.Sprintf("js.Value(%s)", recv), m.name, "value", t)
+	g.printf("}\n")
+}
+
+// set generates the statements that set the given property of the given
+// JS value, branching on nullable values so that null is set without
+// marshalling.
+func (g *generator) set(indent, target, name, expr string, t goType) {
+	if !t.nullable {
+		g.printf("%s%s.Set(%q, %s)\n", indent, target, name, toJS(expr, t))
+		return
+	}
+	elem := t
+	elem.nullable = false
+	g.printf("%sif val, ok := %s.Get(); ok {\n", indent, expr)
+	g.printf("%s\t%s.Set(%q, %s)\n", indent, target, name, toJS("val", elem))
+	g.printf("%s} else {\n", indent)
+	g.printf("%s\t%s.Set(%q, js.Null())\n", indent, target, name)
+	g.printf("%s}\n", indent)
+}
+
+// operation generates a method that calls the given JS operation, or
+// the constructor if the given JS name is empty.
+func (g *generator) operation(recv, typ, name, jsName string, args []*argument, result *idlType) {
+	dict := g.optionsDictionary(args)
+	var positional []*dictionaryMember
+	if dict != nil {
+		args = args[:len(args)-1]
+		positional = g.positionalMembers(dict)
+	}
+
+	var params, values, pre []string
+	var variadic *argument
+	for _, arg := range args {
+		t := g.goType(arg.typ)
+		param := paramName(arg.name, typ)
+		if arg.variadic {
+			variadic = arg
+			params = append(params, fmt.Sprintf("%s ...%s", param, t.name))
+			continue
+		}
+		params = append(params, fmt.Sprintf("%s %s", param, t))
+		if t.nullable {
+			elem := t
+			elem.nullable = false
+			value := param + "JS"
+			pre = append(pre,
+				fmt.Sprintf("%s := js.Null()", value),
+				fmt.Sprintf("if val, ok := %s.Get(); ok {\n\t%s = %s\n}", param, value, jsValue("val", elem)))
+			values = append(values, value)
+			continue
+		}
+		values = append(values, toJS(param, t))
+	}
+	for _, m := range positional {
+		params = append(params, fmt.Sprintf("%s %s", paramName(m.name, typ), g.goType(m.typ)))
+	}
+	if dict != nil {
+		params = append(params, "opts ..."+g.optionType(dict))
+	}
+
+	t := g.resultType(result, false)
+	signature := strings.Join(params, ", ")
+	if t.kind == voidKind {
+		g.printf("func (%s %s) %s(%s) {\n", recv, typ, name, signature)
+	} else {
+		g.printf("func (%s %s) %s(%s) %s {\n", recv, typ, name, signature, t)
+	}
+	for _, stmt := range pre {
+		g.printf("\t%s\n", strings.ReplaceAll(stmt, "\n", "\n\t"))
+	}
+
+	call := func(values []string) string {
+		if jsName == "" {
+			return fmt.Sprintf("js.Value(%s).New(%s)", recv, strings.Join(values, ", "))
+		}
+		return fmt.Sprintf("js.Value(%s).Call(%s)", recv, strings.Join(append([]string{fmt.Sprintf("%q", jsName)}, values...), ", "))
+	}
+
+	switch {
+	case variadic != nil:
+		vt := g.goType(variadic.typ)
+		vt.nullable = false
+		param := paramName(variadic.name, typ)
+		g.printf("\tcallArgs := []any{%s}\n", strings.Join(values, ", "))
+		g.printf("\tfor _, v := range %s {\n\t\tcallArgs = append(callArgs, %s)\n\t}\n", param, toJS("v", vt))
+		g.result(call([]string{"callArgs..."}), t, "\t")
+
+	case len(positional) > 0:
+		g.printf("\toptions := js.ValueOf(map[string]any{})\n")
+		g.printf("\tfor _, opt := range opts {\n\t\topt(options)\n\t}\n")
+		for _, m := range positional {
+			g.set("\t", "options", m.name, paramName(m.name, typ), g.goType(m.typ))
+		}
+		g.result(call(append(values, "options")), t, "\t")
+
+	case dict != nil:
+		g.printf("\tswitch {\n\tcase len(opts) > 0:\n")
+		g.printf("\t\toptions := js.ValueOf(map[string]any{})\n")
+		g.printf("\t\tfor _, opt := range opts {\n\t\t\topt(options)\n\t\t}\n")
+		g.result(call(append(values, "options")), t, "\t\t")
+		g.printf("\n\tdefault:\n")
+		g.result(call(values), t, "\t\t")
+		g.printf("\t}\n")
+
+	default:
+		g.result(call(values), t, "\t")
+	}
+	g.printf("}\n")
+}
+
+// result generates the statements that convert the
+// given JS expression to the given type and return it.
+func (g *generator) result(expr string, t goType, indent string) {
+	switch {
+	case t.kind == voidKind:
+		g.printf("%s%s\n", indent, expr)
+
+	case t.nullable && (t.kind == wrapperKind || t.kind == valueKind):
+		g.printf("%sres := %s\n%sreturn %sNullableAs[%s](res)\n", indent, expr, indent, t.qualifier, t.name)
+
+	case t.nullable:
+		g.printf("%sres := %s\n%sreturn %sNullable%s(res)\n", indent, expr, indent, t.qualifier, valueMethod(t))
+
+	case t.kind == wrapperKind:
+		g.printf("%sres := %s\n%sreturn %s(res)\n", indent, expr, indent, t.name)
+
+	case t.kind == valueKind:
+		g.printf("%sreturn %s\n", indent, expr)
+
+	default:
+		g.printf("%sreturn %s.%s()\n", indent, expr, valueMethod(t))
+	}
+}
+
+// valueMethod returns the js.Value method that converts to the given primitive type.
+func valueMethod(t goType) string {
+	switch t.kind {
+	case boolKind:
+		return "Bool"
+	case intKind:
+		return "Int"
+	case floatKind:
+		return "Float"
+	default:
+		return "String"
+	}
+}
+
+// toJS returns the expression that converts the given Go value to a JS value.
+func toJS(expr string, t goType) string {
+	if t.kind == wrapperKind {
+		return "js.Value(" + expr + ")"
+	}
+	return expr
+}
+
+// jsValue returns the expression that converts the given Go value to a js.Value.
+func jsValue(expr string, t goType) string {
+	if t.kind == wrapperKind {
+		return "js.Value(" + expr + ")"
+	}
+	return "js.ValueOf(" + expr + ")"
+}
+
+// optionsDictionary returns the dictionary of the given arguments
+// if the last argument is a dictionary, or nil otherwise.
+func (g *generator) optionsDictionary(args []*argument) *dictionary {
+	if len(args) == 0 {
+		return nil
+	}
+	last := args[len(args)-1]
+	if last.variadic {
+		return nil
+	}
+	t := g.resolve(last.typ)
+	if t.union != nil || t.params != nil {
+		return nil
+	}
+	return g.defs.lookupDictionary(t.name)
+}
+
+// dictionary generates the options builder of the given dictionary.
+func (g *generator) dictionary(dict *dictionary) {
+	if g.emitted[dict.name] {
+		return
+	}
+	g.emitted[dict.name] = true
+
+	base := g.optionsBase(dict.name)
+	optionsType := lowerCamel(base) + "Options"
+	optionType := g.optionType(dict)
+
+	var members []*dictionaryMember
+	for _, m := range dict.members {
+		if !m.positional {
+			members = append(members, m)
+		}
+	}
+	if len(members) == 0 && g.parentOptions(dict) != "" {
+		// the positional members are set by the operation
+		// and the other options are set by the parent builder
+		return
+	}
+
+	sort.SliceStable(members, func(i, j int) bool {
+		return memberName(members[i].name) < memberName(members[j].name)
+	})
+	g.printf("\n// %sOptions is used to set %s options.\n", base, base)
+	if parent := g.parentOptions(dict); parent != "" {
+		g.printf("//\n// The options in %s can also be used with %s.\n", parent, base)
+	}
+	g.printf("var %sOptions = &%s{}\n\n", base, optionsType)
+	g.printf("type %s struct{}\n", optionsType)
+	if optionType == lowerCamel(base)+"Option" {
+		g.printf("\ntype %s func(value js.Value)\n", optionType)
+	}
+
+	recv := receiverName(optionType)
+	for _, m := range members {
+		t := g.goType(m.typ)
+		param := paramName(m.name, base)
+		if t.kind == boolKind && !t.nullable {
+			param = "enabled"
+		}
+		closure := "value"
+		if param == closure {
+			closure = "options"
+		}
+		g.printf("\n// With%s sets the %s option.\n", memberName(m.name), m.name)
+		if link, ok := g.links[dict.name]; ok {
+			g.printf("//\n// %s#%s\n", link, strings.ToLower(m.name))
+		}
+		g.printf("func (%s %s) With%s(%s %s) %s {\n", recv, optionsType, memberName(m.name), param, t, optionType)
+		g.printf("\treturn func(%s js.Value) {\n", closure)
+		g.set("\t\t", closure, m.name, param, t)
+		g.printf("\t}\n}\n")
+	}
+}
+
+// positionalMembers returns the members of the given dictionary and the
+// dictionaries it inherits from that are passed as arguments.
+func (g *generator) positionalMembers(dict *dictionary) []*dictionaryMember {
+	var res []*dictionaryMember
+	for d := dict; d != nil; d = g.defs.lookupDictionary(d.parent) {
+		for _, m := range d.members {
+			if m.positional {
+				res = append(res, m)
+			}
+		}
+	}
+	return res
+}
+
+// optionsBase returns the base name of the options
+// builder of the dictionary with the given name.
+func (g *generator) optionsBase(name string) string {
+	name = g.typeName(name)
+	for _, suffix := range []string{"Init", "Options"} {
+		if trimmed := strings.TrimSuffix(name, suffix); trimmed != "" && trimmed != name {
+			return trimmed
+		}
+	}
+	return name
+}
+
+// optionType returns the name of the option func type used by the given
+// dictionary, which is shared with the dictionaries it inherits from.
+func (g *generator) optionType(dict *dictionary) string {
+	root := dict.name
+	for parent := dict.parent; parent != ""; {
+		if g.defs.lookupDictionary(parent) == nil && g.cfg.pkg != gojiPackage {
+			break
+		}
+		root = parent
+		if p := g.defs.lookupDictionary(parent); p != nil {
+			parent = p.parent
+		} else {
+			parent = ""
+		}
+	}
+	return lowerCamel(g.optionsBase(root)) + "Option"
+}
+
+// parentOptions returns the name of the options builder
+// of the parent of the given dictionary if it is shared.
+func (g *generator) parentOptions(dict *dictionary) string {
+	if dict.parent == "" || g.optionType(dict) == lowerCamel(g.optionsBase(dict.name))+"Option" {
+		return ""
+	}
+	return g.optionsBase(dict.parent) + "Options"
+}
+
+// resolve returns the given type with typedefs replaced.
+func (g *generator) resolve(t *idlType) *idlType {
+	for i := 0; i < 16; i++ {
+		def, ok := g.defs.typedefs[t.name]
+		if !ok || t.union != nil {
+			return t
+		}
+		res := *def
+		res.nullable = res.nullable || t.nullable
+		t = &res
+	}
+	return t
+}
+
+// goType returns the Go type of the given WebIDL type.
+func (g *generator) goType(t *idlType) goType {
+	t = g.resolve(t)
+	if t.union != nil {
+		return goType{name: "js.Value", kind: valueKind}
+	}
+	var res goType
+	switch t.name {
+	case "FrozenArray":
+		res = goType{name: "ArrayValue", kind: wrapperKind}
+		if g.cfg.pkg != gojiPackage {
+			g.usesGoji = true
+			res.name = "goji.ArrayValue"
+		}
+	case "undefined", "void":
+		return goType{kind: voidKind}
+	case "boolean":
+		res = goType{name: "bool", kind: boolKind}
+	case "byte", "octet", "short", "unsigned short", "long", "unsigned long", "long long", "unsigned long long":
+		res = goType{name: "int", kind: intKind}
+	case "float", "unrestricted float", "double", "unrestricted double":
+		res = goType{name: "float64", kind: floatKind}
+	case "DOMString", "USVString", "ByteString", "CSSOMString":
+		res = goType{name: "string", kind: stringKind}
+	default:
+		switch {
+		case g.defs.lookupEnum(t.name) != nil:
+			res = goType{name: "string", kind: stringKind}
+		case g.isInterface(t.name):
+			res = goType{name: g.typeName(t.name) + "Value", kind: wrapperKind}
+		case gojiTypes[t.name] && g.cfg.pkg == gojiPackage:
+			res = goType{name: t.name + "Value", kind: wrapperKind}
+		case gojiTypes[t.name]:
+			g.usesGoji = true
+			res = goType{name: "goji." + t.name + "Value", kind: wrapperKind}
+		default:
+			return goType{name: "js.Value", kind: valueKind}
+		}
+	}
+	if t.nullable {
+		res.nullable = true
+		if g.cfg.pkg != gojiPackage {
+			g.usesGoji = true
+			res.qualifier = "goji."
+		}
+	}
+	return res
+}
+
+// resultType returns the Go type of the given WebIDL type when it is
+// returned by a getter or method.
+//
+// Unlike arguments, which can be set to js.Null(), nullable js.Value
+// results are returned as goji.Nullable values so that reading a
+// property that is not set is explicit. The force flag makes any
+// result nullable, which is used by the GoNullable extended attribute.
+func (g *generator) resultType(t *idlType, force bool) goType {
+	res := g.goType(t)
+	if res.kind == voidKind || res.nullable || !force && (res.kind != valueKind || !g.resolve(t).nullable) {
+		return res
+	}
+	res.nullable = true
+	if g.cfg.pkg != gojiPackage {
+		g.usesGoji = true
+		res.qualifier = "goji."
+	}
+	return res
+}
+
+// isInterface returns true if the given name is a generated interface.
+func (g *generator) isInterface(name string) bool {
+	iface := g.defs.lookupInterface(name)
+	return iface != nil && !iface.mixin
+}
+
+// typeName returns the Go type name of the given WebIDL name.
+func (g *generator) typeName(name string) string {
+	if trimmed := strings.TrimPrefix(name, g.cfg.trimPrefix); trimmed != "" && unicode.IsUpper([]rune(trimmed)[0]) {
+		return trimmed
+	}
+	return name
+}
+
+// link returns the MDN link of the given interface member.
+func (g *generator) link(iface, member string) string {
+	res := strings.TrimSuffix(g.cfg.mdn, "/") + "/" + iface
+	if member != "" {
+		res += "/" + member
+	}
+	return res
+}
+
+// memberLink returns the MDN link of the given member.
+func (g *generator) memberLink(iface string, m *member) string {
+	if m.static {
+		return g.link(iface, m.name+"_static")
+	}
+	return g.link(iface, m.name)
+}
+
+// initialisms contains the words that are written in upper case in Go names.
+var initialisms = map[string]bool{
+	"API": true, "ASCII": true, "BYOB": true, "CPU": true, "CSS": true, "DB": true,
+	"DNS": true, "DOM": true, "EOF": true, "GUID": true, "HTML": true, "HTTP": true,
+	"HTTPS": true, "ID": true, "IP": true, "JSON": true, "RTC": true, "SQL": true,
+	"TCP": true, "TLS": true, "TTL": true, "UDP": true, "UI": true, "UID": true,
+	"URI": true, "URL": true, "UTF8": true, "UUID": true, "XML": true,
+}
+
+// memberName returns the exported Go name of the given JS member name.
+func memberName(name string) string {
+	var res strings.Builder
+	for _, word := range splitWords(name) {
+		if upper := strings.ToUpper(word); initialisms[upper] {
+			res.WriteString(upper)
+			continue
+		}
+		runes := []rune(word)
+		runes[0] = unicode.ToUpper(runes[0])
+		res.WriteString(string(runes))
+	}
+	return res.String()
+}
+
+// splitWords splits the given camel case name into words.
+func splitWords(name string) []string {
+	var words []string
+	runes := []rune(name)
+	start := 0
+	for i := 1; i < len(runes); i++ {
+		switch {
+		case unicode.IsUpper(runes[i]) && !unicode.IsUpper(runes[i-1]):
+		case unicode.IsUpper(runes[i]) && i+1 < len(runes) && unicode.IsLower(runes[i+1]):
+		case !unicode.IsLetter(runes[i]) && !unicode.IsDigit(runes[i]):
+			if i > start {
+				words = append(words, string(runes[start:i]))
+			}
+			start = i + 1
+			continue
+		default:
+			continue
+		}
+		if i > start {
+			words = append(words, string(runes[start:i]))
+		}
+		start = i
+	}
+	if start < len(runes) {
+		words = append(words, string(runes[start:]))
+	}
+	return words
+}
+
+// lowerCamel returns the given Go name with its leading word in lower case.
+//
+// A leading initialism is lowered as a whole, so
+// DOMException becomes domException.
+func lowerCamel(name string) string {
+	runes := []rune(name)
+	n := 0
+	for n < len(runes) && unicode.IsUpper(runes[n]) {
+		n++
+	}
+	if n > 1 && n < len(runes) && unicode.IsLower(runes[n]) {
+		n--
+	}
+	for i := 0; i < n; i++ {
+		runes[i] = unicode.ToLower(runes[i])
+	}
+	return string(runes)
+}
+
+// reserved contains the names that cannot be used as parameter names.
+var reserved = map[string]bool{
+	"break": true, "case": true, "chan": true, "const": true, "continue": true,
+	"default": true, "defer": true, "else": true, "fallthrough": true, "for": true,
+	"func": true, "go": true, "goto": true, "if": true, "import": true,
+	"interface": true, "map": true, "package": true, "range": true, "return": true,
+	"select": true, "struct": true, "switch": true, "type": true, "var": true,
+	"js": true, "goji": true, "len": true, "new": true, "opts": true, "callArgs": true,
+}
+
+// paramName returns the Go parameter name of the given JS name. Reserved
+// names are prefixed with the last word of the given type name, so type
+// becomes eventType for both Event and CustomEvent, and error becomes err
+// so that it does not shadow the error type.
+func paramName(name, typ string) string {
+	if name == "error" {
+		return "err"
+	}
+	res := lowerCamel(memberName(name))
+	if reserved[res] {
+		words := splitWords(strings.TrimSuffix(strings.TrimSuffix(typ, "JS"), "Value"))
+		return lowerCamel(words[len(words)-1]) + memberName(name)
+	}
+	return res
+}
+
+// receiverName returns the receiver name of the given Go type name.
+func receiverName(name string) string {
+	return string(unicode.ToLower([]rune(name)[0]))
+}
+
+// enumValueName returns the Go name suffix of the given enum value.
+func enumValueName(value string) string {
+	if value == "" {
+		return "Empty"
+	}
+	return memberName(value)
+}
+
+// constantName returns the Go name suffix of the given constant name.
+func constantName(name string) string {
+	return memberName(strings.ToLower(name))
+}
@@ -0,0 +1,105 @@
+//go:build !js
+
+package main
+
+import (
+	"flag"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var update = flag.Bool("update", false, "update the golden files")
+
+func TestGenerate(t *testing.T) {
+	tests := []struct {
+		name string
+		cfg  config
+	}{
+		{name: "event", cfg: config{pkg: "goji"}},
+		{name: "transport", cfg: config{pkg: "web_transport"}},
+		{name: "indexed_db", cfg: config{pkg: "indexed_db", trimPrefix: "IDB"}},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			src, err := os.ReadFile(filepath.Join("testdata", test.name+".webidl"))
+			require.NoError(t, err)
+
+			defs := newDefinitions()
+			require.NoError(t, parse(string(src), defs))
+
+			cfg := test.cfg
+			cfg.mdn = "https://developer.mozilla.org/en-US/docs/Web/API"
+			cfg.sources = []string{test.name + ".webidl"}
+			res, err := generate(defs, cfg)
+			require.NoError(t, err)
+
+			golden := filepath.Join("testdata", test.name+".golden")
+			if *update {
+				require.NoError(t, os.WriteFile(golden, res, 0o644))
+			}
+			expected, err := os.ReadFile(golden)
+			require.NoError(t, err)
+			assert.Equal(t, string(expected), string(res))
+		})
+	}
+}
+
+func TestGenerateWrappers(t *testing.T) {
+	// the wrappers generated from the webidl directory are checked in
+	// the goji package and must match their WebIDL source
+	sources, err := filepath.Glob(filepath.Join("..", "..", "webidl", "*.webidl"))
+	require.NoError(t, err)
+	require.NotEmpty(t, sources)
+
+	for _, source := range sources {
+		name := strings.TrimSuffix(filepath.Base(source), ".webidl")
+		t.Run(name, func(t *testing.T) {
+			output := filepath.Join("..", "..", name+".go")
+			cfg := config{pkg: gojiPackage}
+			cfg.mdn = "https://developer.mozilla.org/en-US/docs/Web/API"
+			if *update {
+				require.NoError(t, run(cfg, []string{source}, output))
+			}
+
+			src, err := os.ReadFile(source)
+			require.NoError(t, err)
+
+			defs := newDefinitions()
+			require.NoError(t, parse(string(src), defs))
+
+			cfg.sources = []string{filepath.Base(source)}
+			res, err := generate(defs, cfg)
+			require.NoError(t, err)
+
+			expected, err := os.ReadFile(output)
+			require.NoError(t, err)
+			assert.Equal(t, string(expected), string(res))
+		})
+	}
+}
+
+func TestMemberName(t *testing.T) {
+	assert.Equal(t, "LastEventID", memberName("lastEventId"))
+	assert.Equal(t, "DB", memberName("db"))
+	assert.Equal(t, "InnerHTML", memberName("innerHTML"))
+	assert.Equal(t, "ObjectStoreNames", memberName("objectStoreNames"))
+	assert.Equal(t, "NoCors", memberName("no-cors"))
+}
+
+func TestParamName(t *testing.T) {
+	assert.Equal(t, "eventType", paramName("type", "customEventJS"))
+	assert.Equal(t, "lastEventID", paramName("lastEventId", "MessageEventValue"))
+	assert.Equal(t, "url", paramName("URL", "WebSocketValue"))
+	assert.Equal(t, "err", paramName("error", "ErrorEventValue"))
+}
+
+func TestLowerCamel(t *testing.T) {
+	assert.Equal(t, "domException", lowerCamel("DOMException"))
+	assert.Equal(t, "event", lowerCamel("Event"))
+	assert.Equal(t, "url", lowerCamel("URL"))
+}
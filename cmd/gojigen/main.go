@@ -0,0 +1,84 @@
+//go:build !js
+
+// Command gojigen generates goji style wrappers from WebIDL definitions.
+//
+// Each interface is generated as a FooValue instance type with getters,
+// setters and methods for its attributes and operations. Interfaces with
+// a constructor or static members also get a fooJS type and a global Foo
+// value. Dictionaries used as the last argument of an operation are
+// generated as FooOptions builders, and enums and constants are
+// generated as Go constants. Nullable types are generated as
+// goji.Nullable values and FrozenArray types as goji.ArrayValue values.
+// Every wrapper links to its MDN page.
+//
+// The comment lines written above an attribute or operation are added to
+// the doc of its wrapper. Dictionary members with the GoPositional
+// extended attribute are passed as arguments instead of options, and
+// attributes with the GoNullable extended attribute are returned as
+// goji.Nullable values even if their WebIDL type is not nullable.
+//
+// The event wrappers of the goji package are generated from the WebIDL
+// files in its webidl directory. They can be regenerated with:
+//
+//	go test ./cmd/gojigen -update
+//
+// Usage:
+//
+//	gojigen [-package name] [-trim-prefix prefix] [-mdn url] [-o output] file.webidl...
+//
+// The wrappers are written to stdout unless an output file is given.
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+	"path/filepath"
+)
+
+func main() {
+	var cfg config
+	flag.StringVar(&cfg.pkg, "package", "goji", "name of the generated package")
+	flag.StringVar(&cfg.trimPrefix, "trim-prefix", "", "prefix removed from the generated type names")
+	flag.StringVar(&cfg.mdn, "mdn", "https://developer.mozilla.org/en-US/docs/Web/API", "base URL of the MDN documentation")
+	output := flag.String("o", "", "output file")
+	flag.Usage = func() {
+		fmt.Fprintf(flag.CommandLine.Output(), "usage: gojigen [flags] file.webidl...\n")
+		flag.PrintDefaults()
+	}
+	flag.Parse()
+
+	if flag.NArg() == 0 {
+		flag.Usage()
+		os.Exit(2)
+	}
+	if err := run(cfg, flag.Args(), *output); err != nil {
+		fmt.Fprintf(os.Stderr, "gojigen: %v\n", err)
+		os.Exit(1)
+	}
+}
+
+// run generates the wrappers for the given WebIDL files
+// and writes them to the given output file.
+func run(cfg config, files []string, output string) error {
+	defs := newDefinitions()
+	for _, name := range files {
+		src, err := os.ReadFile(name)
+		if err != nil {
+			return err
+		}
+		if err := parse(string(src), defs); err != nil {
+			return fmt.Errorf("%s: %w", name, err)
+		}
+		cfg.sources = append(cfg.sources, filepath.Base(name))
+	}
+	res, err := generate(defs, cfg)
+	if err != nil {
+		return err
+	}
+	if output == "" {
+		_, err = os.Stdout.Write(res)
+		return err
+	}
+	return os.WriteFile(output, res, 0o644)
+}
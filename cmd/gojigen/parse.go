@@ -0,0 +1,772 @@
+//go:build !js
+
+package main
+
+import (
+	"fmt"
+	"strings"
+	"unicode"
+)
+
+// definitions contains the parsed WebIDL definitions.
+type definitions struct {
+	interfaces   []*idlInterface
+	dictionaries []*dictionary
+	enums        []*enum
+	typedefs     map[string]*idlType
+	callbacks    map[string]bool
+	// includes contains the mixins included by each interface.
+	includes map[string][]string
+}
+
+// idlInterface is a WebIDL interface or interface mixin.
+type idlInterface struct {
+	name    string
+	parent  string
+	mixin   bool
+	members []*member
+}
+
+// memberKind is the kind of an interface member.
+type memberKind int
+
+const (
+	attributeMember memberKind = iota
+	operationMember
+	constructorMember
+	constantMember
+)
+
+// member is an interface member.
+type member struct {
+	kind     memberKind
+	name     string
+	typ      *idlType
+	readonly bool
+	static   bool
+	args     []*argument
+	value    string
+	// doc contains the comment lines written above the member.
+	doc []string
+	// nullable is true if the member has the GoNullable extended
+	// attribute and its value is returned as a goji.Nullable.
+	nullable bool
+}
+
+// argument is an operation or constructor argument.
+type argument struct {
+	name     string
+	typ      *idlType
+	optional bool
+	variadic bool
+}
+
+// idlType is a WebIDL type.
+type idlType struct {
+	name     string
+	params   []*idlType
+	union    []*idlType
+	nullable bool
+}
+
+// dictionary is a WebIDL dictionary.
+type dictionary struct {
+	name    string
+	parent  string
+	members []*dictionaryMember
+}
+
+// dictionaryMember is a dictionary member.
+type dictionaryMember struct {
+	name     string
+	typ      *idlType
+	required bool
+	// positional is true if the member has the GoPositional extended
+	// attribute and is passed as an argument instead of an option.
+	positional bool
+}
+
+// enum is a WebIDL enum.
+type enum struct {
+	name   string
+	values []string
+}
+
+// tokenKind is the kind of a token.
+type tokenKind int
+
+const (
+	identToken tokenKind = iota
+	stringToken
+	numberToken
+	punctToken
+	eofToken
+)
+
+// token is a lexical token.
+type token struct {
+	kind  tokenKind
+	value string
+	line  int
+	// doc contains the comment lines written on their own lines above the token.
+	doc []string
+}
+
+// tokenize splits the given WebIDL source into tokens.
+//
+// The comment lines written directly above a token are kept as its doc.
+func tokenize(src string) ([]token, error) {
+	var tokens []token
+	var doc []string
+	line := 1
+	// blank is true if nothing precedes the current position on its line.
+	blank := true
+	emit := func(kind tokenKind, value string) {
+		tokens = append(tokens, token{kind: kind, value: value, line: line, doc: doc})
+		doc = nil
+		blank = false
+	}
+	for i := 0; i < len(src); {
+		c := src[i]
+		switch {
+		case c == '\n':
+			if blank {
+				doc = nil
+			}
+			blank = true
+			line++
+			i++
+
+		case c == ' ' || c == '\t' || c == '\r':
+			i++
+
+		case strings.HasPrefix(src[i:], "//"):
+			start := i
+			for i < len(src) && src[i] != '\n' {
+				i++
+			}
+			if blank {
+				doc = append(doc, strings.TrimSpace(src[start+2:i]))
+			}
+			blank = false
+
+		case strings.HasPrefix(src[i:], "/*"):
+			end := strings.Index(src[i+2:], "*/")
+			if end < 0 {
+				return nil, fmt.Errorf("line %d: unterminated comment", line)
+			}
+			line += strings.Count(src[i:i+2+end], "\n")
+			i += end + 4
+			blank = false
+
+		case c == '"':
+			end := strings.IndexByte(src[i+1:], '"')
+			if end < 0 {
+				return nil, fmt.Errorf("line %d: unterminated string", line)
+			}
+			emit(stringToken, src[i+1:i+1+end])
+			i += end + 2
+
+		case strings.HasPrefix(src[i:], "..."):
+			emit(punctToken, "...")
+			i += 3
+
+		case c == '-' || c == '.' || unicode.IsDigit(rune(c)):
+			j := i + 1
+			for j < len(src) && (isIdentByte(src[j]) || src[j] == '.') {
+				j++
+			}
+			if c == '-' && j == i+1 {
+				return nil, fmt.Errorf("line %d: unexpected character %q", line, c)
+			}
+			emit(numberToken, src[i:j])
+			i = j
+
+		case isIdentByte(c):
+			j := i + 1
+			for j < len(src) && (isIdentByte(src[j]) || src[j] == '-') {
+				j++
+			}
+			emit(identToken, src[i:j])
+			i = j
+
+		case strings.ContainsRune("{}()[]<>;:,=?*", rune(c)):
+			emit(punctToken, string(c))
+			i++
+
+		default:
+			return nil, fmt.Errorf("line %d: unexpected character %q", line, c)
+		}
+	}
+	tokens = append(tokens, token{kind: eofToken, line: line})
+	return tokens, nil
+}
+
+// isIdentByte returns true if the given byte can be part of an identifier.
+func isIdentByte(c byte) bool {
+	return c == '_' || c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z' || c >= '0' && c <= '9'
+}
+
+// parser parses WebIDL tokens into definitions.
+type parser struct {
+	tokens []token
+	pos    int
+	defs   *definitions
+	// partials contains the partial interfaces and dictionaries
+	// that are merged once all definitions are parsed.
+	partials []any
+}
+
+// parse parses the given WebIDL source into the given definitions.
+//
+// Only the subset of WebIDL used to generate wrappers is supported.
+// Namespaces, callback interfaces and special operations such as
+// iterable and stringifier declarations are skipped.
+func parse(src string, defs *definitions) error {
+	tokens, err := tokenize(src)
+	if err != nil {
+		return err
+	}
+	p := &parser{tokens: tokens, defs: defs}
+	for p.peek().kind != eofToken {
+		if err := p.definition(); err != nil {
+			return err
+		}
+	}
+	p.mergePartials()
+	return nil
+}
+
+// newDefinitions returns empty definitions.
+func newDefinitions() *definitions {
+	return &definitions{
+		typedefs:  make(map[string]*idlType),
+		callbacks: make(map[string]bool),
+		includes:  make(map[string][]string),
+	}
+}
+
+func (p *parser) peek() token {
+	return p.tokens[p.pos]
+}
+
+func (p *parser) next() token {
+	t := p.tokens[p.pos]
+	if t.kind != eofToken {
+		p.pos++
+	}
+	return t
+}
+
+// accept consumes the next token if it has the given value.
+func (p *parser) accept(value string) bool {
+	if t := p.peek(); t.kind != stringToken && t.value == value {
+		p.pos++
+		return true
+	}
+	return false
+}
+
+// expect consumes the next token and returns an error if it does not have the given value.
+func (p *parser) expect(value string) error {
+	t := p.next()
+	if t.kind == stringToken || t.value != value {
+		return p.errorf(t, "expected %q", value)
+	}
+	return nil
+}
+
+// ident consumes the next token and returns an error if it is not an identifier.
+func (p *parser) ident() (string, error) {
+	t := p.next()
+	if t.kind != identToken {
+		return "", p.errorf(t, "expected identifier")
+	}
+	return t.value, nil
+}
+
+func (p *parser) errorf(t token, format string, args ...any) error {
+	found := t.value
+	if t.kind == eofToken {
+		found = "EOF"
+	}
+	return fmt.Errorf("line %d: %s, found %q", t.line, fmt.Sprintf(format, args...), found)
+}
+
+// skipExtendedAttributes skips an extended attribute list if there is one.
+func (p *parser) skipExtendedAttributes() {
+	p.extendedAttributes()
+}
+
+// extendedAttributes consumes an extended attribute list
+// if there is one and returns the names of its attributes.
+func (p *parser) extendedAttributes() map[string]bool {
+	if p.peek().value != "[" || p.peek().kind != punctToken {
+		return nil
+	}
+	names := make(map[string]bool)
+	depth := 0
+	for prev := ""; ; {
+		t := p.next()
+		switch {
+		case t.kind == eofToken:
+			return names
+		case t.kind == identToken && depth == 1 && (prev == "[" || prev == ","):
+			names[t.value] = true
+		case t.kind != punctToken:
+		case t.value == "[" || t.value == "(":
+			depth++
+		case t.value == "]" || t.value == ")":
+			depth--
+			if depth == 0 {
+				return names
+			}
+		}
+		prev = t.value
+	}
+}
+
+// skipBalanced skips tokens up to and including the
+// close token that balances the current open token.
+func (p *parser) skipBalanced(open, close string) {
+	depth := 0
+	for {
+		t := p.next()
+		switch {
+		case t.kind == eofToken:
+			return
+		case t.kind == punctToken && t.value == open:
+			depth++
+		case t.kind == punctToken && t.value == close:
+			depth--
+			if depth == 0 {
+				return
+			}
+		}
+	}
+}
+
+// skipStatement skips tokens up to and including the next top level semicolon.
+func (p *parser) skipStatement() {
+	depth := 0
+	for {
+		t := p.next()
+		if t.kind == eofToken {
+			return
+		}
+		if t.kind != punctToken {
+			continue
+		}
+		switch t.value {
+		case "{", "(", "[":
+			depth++
+		case "}", ")", "]":
+			depth--
+		case ";":
+			if depth <= 0 {
+				return
+			}
+		}
+	}
+}
+
+// definition parses a top level definition.
+func (p *parser) definition() error {
+	p.skipExtendedAttributes()
+	t := p.peek()
+	switch {
+	case p.accept("partial"):
+		switch {
+		case p.accept("interface"):
+			mixin := p.accept("mixin")
+			iface, err := p.interfaceBody(mixin)
+			if err != nil {
+				return err
+			}
+			p.partials = append(p.partials, iface)
+			return nil
+
+		case p.accept("dictionary"):
+			dict, err := p.dictionaryBody()
+			if err != nil {
+				return err
+			}
+			p.partials = append(p.partials, dict)
+			return nil
+		}
+		p.skipStatement()
+		return nil
+
+	case p.accept("interface"):
+		mixin := p.accept("mixin")
+		iface, err := p.interfaceBody(mixin)
+		if err != nil {
+			return err
+		}
+		p.defs.interfaces = append(p.defs.interfaces, iface)
+		return nil
+
+	case p.accept("dictionary"):
+		dict, err := p.dictionaryBody()
+		if err != nil {
+			return err
+		}
+		p.defs.dictionaries = append(p.defs.dictionaries, dict)
+		return nil
+
+	case p.accept("enum"):
+		return p.enum()
+
+	case p.accept("typedef"):
+		p.skipExtendedAttributes()
+		typ, err := p.typ()
+		if err != nil {
+			return err
+		}
+		name, err := p.ident()
+		if err != nil {
+			return err
+		}
+		p.defs.typedefs[name] = typ
+		return p.expect(";")
+
+	case p.accept("callback"):
+		p.accept("interface")
+		name, err := p.ident()
+		if err != nil {
+			return err
+		}
+		p.defs.callbacks[name] = true
+		p.skipStatement()
+		return nil
+
+	case p.accept("namespace"):
+		p.skipStatement()
+		return nil
+
+	case t.kind == identToken:
+		target := p.next().value
+		if err := p.expect("includes"); err != nil {
+			return err
+		}
+		mixin, err := p.ident()
+		if err != nil {
+			return err
+		}
+		p.defs.includes[target] = append(p.defs.includes[target], mixin)
+		return p.expect(";")
+	}
+	return p.errorf(t, "expected definition")
+}
+
+// interfaceBody parses the name, parent and members of an interface.
+func (p *parser) interfaceBody(mixin bool) (*idlInterface, error) {
+	name, err := p.ident()
+	if err != nil {
+		return nil, err
+	}
+	iface := &idlInterface{name: name, mixin: mixin}
+	if p.accept(":") {
+		if iface.parent, err = p.ident(); err != nil {
+			return nil, err
+		}
+	}
+	if err := p.expect("{"); err != nil {
+		return nil, err
+	}
+	for !p.accept("}") {
+		if p.peek().kind == eofToken {
+			return nil, p.errorf(p.peek(), "expected }")
+		}
+		m, err := p.member()
+		if err != nil {
+			return nil, err
+		}
+		if m != nil {
+			iface.members = append(iface.members, m)
+		}
+	}
+	return iface, p.expect(";")
+}
+
+// member parses an interface member or returns nil if the member is not supported.
+func (p *parser) member() (*member, error) {
+	doc := p.peek().doc
+	attrs := p.extendedAttributes()
+	switch {
+	case p.accept("const"):
+		typ, err := p.typ()
+		if err != nil {
+			return nil, err
+		}
+		name, err := p.ident()
+		if err != nil {
+			return nil, err
+		}
+		if err := p.expect("="); err != nil {
+			return nil, err
+		}
+		value := p.next().value
+		return &member{kind: constantMember, name: name, typ: typ, value: value}, p.expect(";")
+
+	case p.accept("constructor"):
+		args, err := p.arguments()
+		if err != nil {
+			return nil, err
+		}
+		return &member{kind: constructorMember, args: args}, p.expect(";")
+	}
+
+	switch p.peek().value {
+	case "stringifier", "iterable", "async", "maplike", "setlike", "getter", "setter", "deleter":
+		p.skipStatement()
+		return nil, nil
+	}
+
+	m := &member{doc: doc, nullable: attrs["GoNullable"]}
+	m.static = p.accept("static")
+	p.accept("inherit")
+	m.readonly = p.accept("readonly")
+	if p.accept("attribute") {
+		typ, err := p.typ()
+		if err != nil {
+			return nil, err
+		}
+		m.kind = attributeMember
+		m.typ = typ
+		if m.name, err = p.ident(); err != nil {
+			return nil, err
+		}
+		return m, p.expect(";")
+	}
+
+	typ, err := p.typ()
+	if err != nil {
+		return nil, err
+	}
+	m.kind = operationMember
+	m.typ = typ
+	if m.name, err = p.ident(); err != nil {
+		return nil, err
+	}
+	if m.args, err = p.arguments(); err != nil {
+		return nil, err
+	}
+	return m, p.expect(";")
+}
+
+// arguments parses a parenthesized argument list.
+func (p *parser) arguments() ([]*argument, error) {
+	if err := p.expect("("); err != nil {
+		return nil, err
+	}
+	var args []*argument
+	for !p.accept(")") {
+		if len(args) > 0 {
+			if err := p.expect(","); err != nil {
+				return nil, err
+			}
+		}
+		p.skipExtendedAttributes()
+		arg := &argument{optional: p.accept("optional")}
+		typ, err := p.typ()
+		if err != nil {
+			return nil, err
+		}
+		arg.typ = typ
+		arg.variadic = p.accept("...")
+		if arg.name, err = p.ident(); err != nil {
+			return nil, err
+		}
+		if p.accept("=") {
+			p.defaultValue()
+		}
+		args = append(args, arg)
+	}
+	return args, nil
+}
+
+// defaultValue skips a default value.
+func (p *parser) defaultValue() {
+	switch p.peek().value {
+	case "[":
+		p.skipBalanced("[", "]")
+	case "{":
+		p.skipBalanced("{", "}")
+	default:
+		p.next()
+	}
+}
+
+// typ parses a type.
+func (p *parser) typ() (*idlType, error) {
+	p.skipExtendedAttributes()
+	if p.accept("(") {
+		t := &idlType{}
+		for {
+			member, err := p.typ()
+			if err != nil {
+				return nil, err
+			}
+			t.union = append(t.union, member)
+			if p.accept(")") {
+				break
+			}
+			if err := p.expect("or"); err != nil {
+				return nil, err
+			}
+		}
+		t.nullable = p.accept("?")
+		return t, nil
+	}
+
+	name, err := p.ident()
+	if err != nil {
+		return nil, err
+	}
+	switch name {
+	case "unsigned", "unrestricted":
+		word, err := p.ident()
+		if err != nil {
+			return nil, err
+		}
+		name += " " + word
+		if word == "long" && p.accept("long") {
+			name += " long"
+		}
+	case "long":
+		if p.accept("long") {
+			name += " long"
+		}
+	}
+	t := &idlType{name: name}
+	if p.accept("<") {
+		for {
+			param, err := p.typ()
+			if err != nil {
+				return nil, err
+			}
+			t.params = append(t.params, param)
+			if p.accept(">") {
+				break
+			}
+			if err := p.expect(","); err != nil {
+				return nil, err
+			}
+		}
+	}
+	t.nullable = p.accept("?")
+	return t, nil
+}
+
+// dictionaryBody parses the name, parent and members of a dictionary.
+func (p *parser) dictionaryBody() (*dictionary, error) {
+	name, err := p.ident()
+	if err != nil {
+		return nil, err
+	}
+	dict := &dictionary{name: name}
+	if p.accept(":") {
+		if dict.parent, err = p.ident(); err != nil {
+			return nil, err
+		}
+	}
+	if err := p.expect("{"); err != nil {
+		return nil, err
+	}
+	for !p.accept("}") {
+		attrs := p.extendedAttributes()
+		m := &dictionaryMember{positional: attrs["GoPositional"], required: p.accept("required")}
+		if m.typ, err = p.typ(); err != nil {
+			return nil, err
+		}
+		if m.name, err = p.ident(); err != nil {
+			return nil, err
+		}
+		if p.accept("=") {
+			p.defaultValue()
+		}
+		if err := p.expect(";"); err != nil {
+			return nil, err
+		}
+		dict.members = append(dict.members, m)
+	}
+	return dict, p.expect(";")
+}
+
+// enum parses the name and values of an enum.
+func (p *parser) enum() error {
+	name, err := p.ident()
+	if err != nil {
+		return err
+	}
+	e := &enum{name: name}
+	if err := p.expect("{"); err != nil {
+		return err
+	}
+	for !p.accept("}") {
+		t := p.next()
+		switch {
+		case t.kind == stringToken:
+			e.values = append(e.values, t.value)
+		case t.kind == punctToken && t.value == ",":
+		default:
+			return p.errorf(t, "expected string")
+		}
+	}
+	p.defs.enums = append(p.defs.enums, e)
+	return p.expect(";")
+}
+
+// mergePartials merges the partial definitions into the definitions
+// they extend, or adds them if the definitions do not exist.
+func (p *parser) mergePartials() {
+	for _, partial := range p.partials {
+		switch partial := partial.(type) {
+		case *idlInterface:
+			if iface := p.defs.lookupInterface(partial.name); iface != nil {
+				iface.members = append(iface.members, partial.members...)
+			} else {
+				p.defs.interfaces = append(p.defs.interfaces, partial)
+			}
+
+		case *dictionary:
+			if dict := p.defs.lookupDictionary(partial.name); dict != nil {
+				dict.members = append(dict.members, partial.members...)
+			} else {
+				p.defs.dictionaries = append(p.defs.dictionaries, partial)
+			}
+		}
+	}
+	p.partials = nil
+}
+
+// lookupInterface returns the interface or mixin with the given name.
+func (d *definitions) lookupInterface(name string) *idlInterface {
+	for _, iface := range d.interfaces {
+		if iface.name == name {
+			return iface
+		}
+	}
+	return nil
+}
+
+// lookupDictionary returns the dictionary with the given name.
+func (d *definitions) lookupDictionary(name string) *dictionary {
+	for _, dict := range d.dictionaries {
+		if dict.name == name {
+			return dict
+		}
+	}
+	return nil
+}
+
+// lookupEnum returns the enum with the given name.
+func (d *definitions) lookupEnum(name string) *enum {
+	for _, e := range d.enums {
+		if e.name == name {
+			return e
+		}
+	}
+	return nil
+}
@@ -0,0 +1,133 @@
+//go:build !js
+
+package main
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseInterface(t *testing.T) {
+	defs := newDefinitions()
+	err := parse(`
+		[Exposed=Window]
+		interface Foo : Bar {
+			constructor(optional DOMString name = "");
+			const long MAX = 10;
+			readonly attribute unsigned long long size;
+			attribute (DOMString or sequence<long>)? value;
+			static Promise<undefined> wait(long ms);
+			undefined log(any... data);
+			iterable<DOMString>;
+			stringifier;
+		};
+	`, defs)
+	require.NoError(t, err)
+	require.Len(t, defs.interfaces, 1)
+
+	iface := defs.interfaces[0]
+	assert.Equal(t, "Foo", iface.name)
+	assert.Equal(t, "Bar", iface.parent)
+	require.Len(t, iface.members, 6)
+
+	assert.Equal(t, constructorMember, iface.members[0].kind)
+	assert.True(t, iface.members[0].args[0].optional)
+
+	assert.Equal(t, constantMember, iface.members[1].kind)
+	assert.Equal(t, "10", iface.members[1].value)
+
+	assert.Equal(t, attributeMember, iface.members[2].kind)
+	assert.True(t, iface.members[2].readonly)
+	assert.Equal(t, "unsigned long long", iface.members[2].typ.name)
+
+	assert.Len(t, iface.members[3].typ.union, 2)
+	assert.True(t, iface.members[3].typ.nullable)
+
+	assert.True(t, iface.members[4].static)
+	assert.Equal(t, "Promise", iface.members[4].typ.name)
+	assert.Equal(t, "undefined", iface.members[4].typ.params[0].name)
+
+	assert.True(t, iface.members[5].args[0].variadic)
+}
+
+func TestParsePartialAndMixin(t *testing.T) {
+	defs := newDefinitions()
+	err := parse(`
+		partial interface Foo {
+			readonly attribute long b;
+		};
+		interface Foo {
+			readonly attribute long a;
+		};
+		interface mixin Baz {
+			readonly attribute long c;
+		};
+		Foo includes Baz;
+		partial dictionary FooInit {
+			long d;
+		};
+	`, defs)
+	require.NoError(t, err)
+	require.Len(t, defs.interfaces, 2)
+
+	assert.Len(t, defs.interfaces[0].members, 2)
+	assert.True(t, defs.interfaces[1].mixin)
+	assert.Equal(t, []string{"Baz"}, defs.includes["Foo"])
+	require.Len(t, defs.dictionaries, 1)
+	assert.Equal(t, "d", defs.dictionaries[0].members[0].name)
+}
+
+func TestParseEnumAndTypedef(t *testing.T) {
+	defs := newDefinitions()
+	err := parse(`
+		enum Mode { "a", "b-c" };
+		typedef [Clamp] unsigned long long Size;
+		callback Handler = undefined (Event event);
+		namespace console { undefined log(any... data); };
+	`, defs)
+	require.NoError(t, err)
+	require.Len(t, defs.enums, 1)
+	assert.Equal(t, []string{"a", "b-c"}, defs.enums[0].values)
+	assert.Equal(t, "unsigned long long", defs.typedefs["Size"].name)
+	assert.True(t, defs.callbacks["Handler"])
+}
+
+func TestParseDocAndPositional(t *testing.T) {
+	defs := newDefinitions()
+	err := parse(`
+		interface Foo {
+			// The value is null
+			// if it is not set.
+			readonly attribute long? value;
+
+			// not the doc of size
+			
+			[Exposed=(Window,Worker)] readonly attribute long size; // trailing
+			[GoNullable] readonly attribute any count;
+		};
+		dictionary FooInit {
+			[GoPositional] any detail = null;
+			[Clamp] long size;
+		};
+	`, defs)
+	require.NoError(t, err)
+
+	members := defs.interfaces[0].members
+	assert.Equal(t, []string{"The value is null", "if it is not set."}, members[0].doc)
+	assert.Empty(t, members[1].doc)
+	assert.Empty(t, members[2].doc)
+	assert.False(t, members[1].nullable)
+	assert.True(t, members[2].nullable)
+
+	dict := defs.dictionaries[0]
+	assert.True(t, dict.members[0].positional)
+	assert.False(t, dict.members[1].positional)
+}
+
+func TestParseError(t *testing.T) {
+	defs := newDefinitions()
+	err := parse(`interface Foo { readonly attribute long; };`, defs)
+	assert.ErrorContains(t, err, "line 1: expected identifier")
+}
@@ -0,0 +1,198 @@
+//go:build js
+
+package goji
+
+import (
+	"strconv"
+	"syscall/js"
+)
+
+func init() {
+	DataView = dataViewJS(js.Global().Get("DataView"))
+}
+
+type dataViewJS js.Value
+
+// DataView is a wrapper for the DataView global object.
+//
+// https://developer.mozilla.org/en-US/docs/Web/JavaScript/Reference/Global_Objects/DataView
+var DataView dataViewJS
+
+// DataViewValue is an instance of DataView.
+type DataViewValue js.Value
+
+// New wraps the data view constructor.
+//
+// https://developer.mozilla.org/en-US/docs/Web/JavaScript/Reference/Global_Objects/DataView/DataView
+func (d dataViewJS) New(buffer js.Value, byteOffset, byteLength int) DataViewValue {
+	res := js.Value(d).New(buffer, byteOffset, byteLength)
+	return DataViewValue(res)
+}
+
+// Buffer wraps the data view buffer property.
+//
+// https://developer.mozilla.org/en-US/docs/Web/JavaScript/Reference/Global_Objects/DataView/buffer
+func (d DataViewValue) Buffer() ArrayBufferValue {
+	res := js.Value(d).Get("buffer")
+	return ArrayBufferValue(res)
+}
+
+// ByteLength wraps the data view byteLength property.
+//
+// https://developer.mozilla.org/en-US/docs/Web/JavaScript/Reference/Global_Objects/DataView/byteLength
+func (d DataViewValue) ByteLength() int {
+	return js.Value(d).Get("byteLength").Int()
+}
+
+// ByteOffset wraps the data view byteOffset property.
+//
+// https://developer.mozilla.org/en-US/docs/Web/JavaScript/Reference/Global_Objects/DataView/byteOffset
+func (d DataViewValue) ByteOffset() int {
+	return js.Value(d).Get("byteOffset").Int()
+}
+
+// GetInt8 wraps the data view getInt8 instance method.
+//
+// https://developer.mozilla.org/en-US/docs/Web/JavaScript/Reference/Global_Objects/DataView/getInt8
+func (d DataViewValue) GetInt8(byteOffset int) int8 {
+	return int8(js.Value(d).Call("getInt8", byteOffset).Int())
+}
+
+// SetInt8 wraps the data view setInt8 instance method.
+//
+// https://developer.mozilla.org/en-US/docs/Web/JavaScript/Reference/Global_Objects/DataView/setInt8
+func (d DataViewValue) SetInt8(byteOffset int, value int8) {
+	js.Value(d).Call("setInt8", byteOffset, value)
+}
+
+// GetUint8 wraps the data view getUint8 instance method.
+//
+// https://developer.mozilla.org/en-US/docs/Web/JavaScript/Reference/Global_Objects/DataView/getUint8
+func (d DataViewValue) GetUint8(byteOffset int) uint8 {
+	return uint8(js.Value(d).Call("getUint8", byteOffset).Int())
+}
+
+// SetUint8 wraps the data view setUint8 instance method.
+//
+// https://developer.mozilla.org/en-US/docs/Web/JavaScript/Reference/Global_Objects/DataView/setUint8
+func (d DataViewValue) SetUint8(byteOffset int, value uint8) {
+	js.Value(d).Call("setUint8", byteOffset, value)
+}
+
+// GetInt16 wraps the data view getInt16 instance method.
+//
+// https://developer.mozilla.org/en-US/docs/Web/JavaScript/Reference/Global_Objects/DataView/getInt16
+func (d DataViewValue) GetInt16(byteOffset int, littleEndian bool) int16 {
+	return int16(js.Value(d).Call("getInt16", byteOffset, littleEndian).Int())
+}
+
+// SetInt16 wraps the data view setInt16 instance method.
+//
+// https://developer.mozilla.org/en-US/docs/Web/JavaScript/Reference/Global_Objects/DataView/setInt16
+func (d DataViewValue) SetInt16(byteOffset int, value int16, littleEndian bool) {
+	js.Value(d).Call("setInt16", byteOffset, value, littleEndian)
+}
+
+// GetUint16 wraps the data view getUint16 instance method.
+//
+// https://developer.mozilla.org/en-US/docs/Web/JavaScript/Reference/Global_Objects/DataView/getUint16
+func (d DataViewValue) GetUint16(byteOffset int, littleEndian bool) uint16 {
+	return uint16(js.Value(d).Call("getUint16", byteOffset, littleEndian).Int())
+}
+
+// SetUint16 wraps the data view setUint16 instance method.
+//
+// https://developer.mozilla.org/en-US/docs/Web/JavaScript/Reference/Global_Objects/DataView/setUint16
+func (d DataViewValue) SetUint16(byteOffset int, value uint16, littleEndian bool) {
+	js.Value(d).Call("setUint16", byteOffset, value, littleEndian)
+}
+
+// GetInt32 wraps the data view getInt32 instance method.
+//
+// https://developer.mozilla.org/en-US/docs/Web/JavaScript/Reference/Global_Objects/DataView/getInt32
+func (d DataViewValue) GetInt32(byteOffset int, littleEndian bool) int32 {
+	return int32(js.Value(d).Call("getInt32", byteOffset, littleEndian).Int())
+}
+
+// SetInt32 wraps the data view setInt32 instance method.
+//
+// https://developer.mozilla.org/en-US/docs/Web/JavaScript/Reference/Global_Objects/DataView/setInt32
+func (d DataViewValue) SetInt32(byteOffset int, value int32, littleEndian bool) {
+	js.Value(d).Call("setInt32", byteOffset, value, littleEndian)
+}
+
+// GetUint32 wraps the data view getUint32 instance method.
+//
+// https://developer.mozilla.org/en-US/docs/Web/JavaScript/Reference/Global_Objects/DataView/getUint32
+func (d DataViewValue) GetUint32(byteOffset int, littleEndian bool) uint32 {
+	return uint32(js.Value(d).Call("getUint32", byteOffset, littleEndian).Float())
+}
+
+// SetUint32 wraps the data view setUint32 instance method.
+//
+// https://developer.mozilla.org/en-US/docs/Web/JavaScript/Reference/Global_Objects/DataView/setUint32
+func (d DataViewValue) SetUint32(byteOffset int, value uint32, littleEndian bool) {
+	js.Value(d).Call("setUint32", byteOffset, value, littleEndian)
+}
+
+// GetFloat32 wraps the data view getFloat32 instance method.
+//
+// https://developer.mozilla.org/en-US/docs/Web/JavaScript/Reference/Global_Objects/DataView/getFloat32
+func (d DataViewValue) GetFloat32(byteOffset int, littleEndian bool) float32 {
+	return float32(js.Value(d).Call("getFloat32", byteOffset, littleEndian).Float())
+}
+
+// SetFloat32 wraps the data view setFloat32 instance method.
+//
+// https://developer.mozilla.org/en-US/docs/Web/JavaScript/Reference/Global_Objects/DataView/setFloat32
+func (d DataViewValue) SetFloat32(byteOffset int, value float32, littleEndian bool) {
+	js.Value(d).Call("setFloat32", byteOffset, value, littleEndian)
+}
+
+// GetFloat64 wraps the data view getFloat64 instance method.
+//
+// https://developer.mozilla.org/en-US/docs/Web/JavaScript/Reference/Global_Objects/DataView/getFloat64
+func (d DataViewValue) GetFloat64(byteOffset int, littleEndian bool) float64 {
+	return float64(js.Value(d).Call("getFloat64", byteOffset, littleEndian).Float())
+}
+
+// SetFloat64 wraps the data view setFloat64 instance method.
+//
+// https://developer.mozilla.org/en-US/docs/Web/JavaScript/Reference/Global_Objects/DataView/setFloat64
+func (d DataViewValue) SetFloat64(byteOffset int, value float64, littleEndian bool) {
+	js.Value(d).Call("setFloat64", byteOffset, value, littleEndian)
+}
+
+// GetBigInt64 wraps the data view getBigInt64 instance method.
+//
+// https://developer.mozilla.org/en-US/docs/Web/JavaScript/Reference/Global_Objects/DataView/getBigInt64
+func (d DataViewValue) GetBigInt64(byteOffset int, littleEndian bool) int64 {
+	res := js.Value(d).Call("getBigInt64", byteOffset, littleEndian)
+	n, _ := strconv.ParseInt(bigIntString(res), 10, 64)
+	return n
+}
+
+// SetBigInt64 wraps the data view setBigInt64 instance method.
+//
+// https://developer.mozilla.org/en-US/docs/Web/JavaScript/Reference/Global_Objects/DataView/setBigInt64
+func (d DataViewValue) SetBigInt64(byteOffset int, value int64, littleEndian bool) {
+	n := jsBigInt.Invoke(strconv.FormatInt(value, 10))
+	js.Value(d).Call("setBigInt64", byteOffset, n, littleEndian)
+}
+
+// GetBigUint64 wraps the data view getBigUint64 instance method.
+//
+// https://developer.mozilla.org/en-US/docs/Web/JavaScript/Reference/Global_Objects/DataView/getBigUint64
+func (d DataViewValue) GetBigUint64(byteOffset int, littleEndian bool) uint64 {
+	res := js.Value(d).Call("getBigUint64", byteOffset, littleEndian)
+	n, _ := strconv.ParseUint(bigIntString(res), 10, 64)
+	return n
+}
+
+// SetBigUint64 wraps the data view setBigUint64 instance method.
+//
+// https://developer.mozilla.org/en-US/docs/Web/JavaScript/Reference/Global_Objects/DataView/setBigUint64
+func (d DataViewValue) SetBigUint64(byteOffset int, value uint64, littleEndian bool) {
+	n := jsBigInt.Invoke(strconv.FormatUint(value, 10))
+	js.Value(d).Call("setBigUint64", byteOffset, n, littleEndian)
+}
@@ -0,0 +1,38 @@
+//go:build js
+
+package goji
+
+import (
+	"math"
+	"syscall/js"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDataView(t *testing.T) {
+	buffer := ArrayBuffer.New(16)
+	view := DataView.New(js.Value(buffer), 0, 16)
+	assert.Equal(t, 16, view.ByteLength())
+	assert.Equal(t, 0, view.ByteOffset())
+
+	view.SetInt8(0, -1)
+	assert.Equal(t, int8(-1), view.GetInt8(0))
+	assert.Equal(t, uint8(math.MaxUint8), view.GetUint8(0))
+
+	view.SetUint16(0, 0x0102, false)
+	assert.Equal(t, uint16(0x0102), view.GetUint16(0, false))
+	assert.Equal(t, uint16(0x0201), view.GetUint16(0, true))
+
+	view.SetUint32(0, math.MaxUint32, true)
+	assert.Equal(t, uint32(math.MaxUint32), view.GetUint32(0, true))
+
+	view.SetFloat64(8, 1.5, true)
+	assert.Equal(t, 1.5, view.GetFloat64(8, true))
+
+	view.SetBigInt64(0, math.MinInt64, true)
+	assert.Equal(t, int64(math.MinInt64), view.GetBigInt64(0, true))
+
+	view.SetBigUint64(8, math.MaxUint64, false)
+	assert.Equal(t, uint64(math.MaxUint64), view.GetBigUint64(8, false))
+}
@@ -0,0 +1,115 @@
+//go:build js
+
+package goji
+
+import (
+	"syscall/js"
+	"time"
+)
+
+func init() {
+	Date = dateJS(js.Global().Get("Date"))
+}
+
+type dateJS js.Value
+
+// Date is a wrapper for the Date global object.
+//
+// https://developer.mozilla.org/en-US/docs/Web/JavaScript/Reference/Global_Objects/Date
+var Date dateJS
+
+// DateValue is an instance of Date.
+type DateValue js.Value
+
+// New wraps the date constructor.
+//
+// https://developer.mozilla.org/en-US/docs/Web/JavaScript/Reference/Global_Objects/Date/Date
+func (d dateJS) New(values ...any) DateValue {
+	res := js.Value(d).New(values...)
+	return DateValue(res)
+}
+
+// Now wraps the date now static method.
+//
+// https://developer.mozilla.org/en-US/docs/Web/JavaScript/Reference/Global_Objects/Date/now
+func (d dateJS) Now() int {
+	return js.Value(d).Call("now").Int()
+}
+
+// Parse wraps the date parse static method.
+//
+// https://developer.mozilla.org/en-US/docs/Web/JavaScript/Reference/Global_Objects/Date/parse
+func (d dateJS) Parse(dateString string) float64 {
+	return js.Value(d).Call("parse", dateString).Float()
+}
+
+// UTC wraps the date UTC static method.
+//
+// https://developer.mozilla.org/en-US/docs/Web/JavaScript/Reference/Global_Objects/Date/UTC
+func (d dateJS) UTC(year int, rest ...any) float64 {
+	args := append([]any{year}, rest...)
+	return js.Value(d).Call("UTC", args...).Float()
+}
+
+// GetTime wraps the date getTime instance method.
+//
+// https://developer.mozilla.org/en-US/docs/Web/JavaScript/Reference/Global_Objects/Date/getTime
+func (d DateValue) GetTime() float64 {
+	return js.Value(d).Call("getTime").Float()
+}
+
+// GetTimezoneOffset wraps the date getTimezoneOffset instance method.
+//
+// https://developer.mozilla.org/en-US/docs/Web/JavaScript/Reference/Global_Objects/Date/getTimezoneOffset
+func (d DateValue) GetTimezoneOffset() int {
+	return js.Value(d).Call("getTimezoneOffset").Int()
+}
+
+// SetTime wraps the date setTime instance method.
+//
+// https://developer.mozilla.org/en-US/docs/Web/JavaScript/Reference/Global_Objects/Date/setTime
+func (d DateValue) SetTime(timeValue float64) float64 {
+	return js.Value(d).Call("setTime", timeValue).Float()
+}
+
+// ToISOString wraps the date toISOString instance method.
+//
+// https://developer.mozilla.org/en-US/docs/Web/JavaScript/Reference/Global_Objects/Date/toISOString
+func (d DateValue) ToISOString() string {
+	return js.Value(d).Call("toISOString").String()
+}
+
+// ToJSON wraps the date toJSON instance method.
+//
+// https://developer.mozilla.org/en-US/docs/Web/JavaScript/Reference/Global_Objects/Date/toJSON
+func (d DateValue) ToJSON() string {
+	return js.Value(d).Call("toJSON").String()
+}
+
+// ToString wraps the date toString instance method.
+//
+// https://developer.mozilla.org/en-US/docs/Web/JavaScript/Reference/Global_Objects/Date/toString
+func (d DateValue) ToString() string {
+	return js.Value(d).Call("toString").String()
+}
+
+// ToUTCString wraps the date toUTCString instance method.
+//
+// https://developer.mozilla.org/en-US/docs/Web/JavaScript/Reference/Global_Objects/Date/toUTCString
+func (d DateValue) ToUTCString() string {
+	return js.Value(d).Call("toUTCString").String()
+}
+
+// Time returns the date as a time.Time.
+//
+// Dates have millisecond precision so the result never
+// contains a fraction of a millisecond.
+func (d DateValue) Time() time.Time {
+	return time.UnixMilli(int64(d.GetTime()))
+}
+
+// DateFromTime is a helper function that returns
+// a new Date with the same instant as the given time.
+func DateFromTime(t time.Time) DateValue {
+	return Date.New(float64(t.UnixMilli()))
+}
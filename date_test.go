@@ -0,0 +1,37 @@
+//go:build js
+
+package goji
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewDate(t *testing.T) {
+	d := Date.New("2024-01-02T03:04:05.006Z")
+	assert.Equal(t, "2024-01-02T03:04:05.006Z", d.ToISOString())
+	assert.Equal(t, Date.Parse("2024-01-02T03:04:05.006Z"), d.GetTime())
+}
+
+func TestDateUTC(t *testing.T) {
+	res := Date.UTC(2024, 0, 2)
+	expect := time.Date(2024, time.January, 2, 0, 0, 0, 0, time.UTC)
+	assert.Equal(t, float64(expect.UnixMilli()), res)
+}
+
+func TestDateFromTime(t *testing.T) {
+	expect := time.Date(2024, time.January, 2, 3, 4, 5, 6000000, time.UTC)
+
+	d := DateFromTime(expect)
+	assert.Equal(t, "2024-01-02T03:04:05.006Z", d.ToISOString())
+	assert.True(t, expect.Equal(d.Time()))
+}
+
+func TestDateTimeTruncatesToMilliseconds(t *testing.T) {
+	expect := time.Date(2024, time.January, 2, 3, 4, 5, 6000001, time.UTC)
+
+	d := DateFromTime(expect)
+	assert.True(t, expect.Truncate(time.Millisecond).Equal(d.Time()))
+}
@@ -0,0 +1,146 @@
+//go:build js
+
+package goji
+
+import (
+	"context"
+	"errors"
+	"syscall/js"
+)
+
+func init() {
+	DOMException = domExceptionJS(js.Global().Get("DOMException"))
+}
+
+// Sentinel errors matching the DOMException names.
+//
+// A DOMException named AbortError matches context.Canceled and a
+// DOMException named TimeoutError matches context.DeadlineExceeded.
+//
+// https://developer.mozilla.org/en-US/docs/Web/API/DOMException#error_names
+var (
+	ErrIndexSize             = errors.New("index size error")
+	ErrHierarchyRequest      = errors.New("hierarchy request error")
+	ErrWrongDocument         = errors.New("wrong document error")
+	ErrInvalidCharacter      = errors.New("invalid character error")
+	ErrNoModificationAllowed = errors.New("no modification allowed error")
+	ErrNotFound              = errors.New("not found error")
+	ErrNotSupported          = errors.New("not supported error")
+	ErrInvalidState          = errors.New("invalid state error")
+	ErrInUseAttribute        = errors.New("in use attribute error")
+	ErrSyntax                = errors.New("syntax error")
+	ErrInvalidModification   = errors.New("invalid modification error")
+	ErrNamespace             = errors.New("namespace error")
+	ErrInvalidAccess         = errors.New("invalid access error")
+	ErrTypeMismatch          = errors.New("type mismatch error")
+	ErrSecurity              = errors.New("security error")
+	ErrNetwork               = errors.New("network error")
+	ErrURLMismatch           = errors.New("url mismatch error")
+	ErrQuotaExceeded         = errors.New("quota exceeded error")
+	ErrInvalidNodeType       = errors.New("invalid node type error")
+	ErrDataClone             = errors.New("data clone error")
+	ErrEncoding              = errors.New("encoding error")
+	ErrNotReadable           = errors.New("not readable error")
+	ErrUnknown               = errors.New("unknown error")
+	ErrConstraint            = errors.New("constraint error")
+	ErrData                  = errors.New("data error")
+	ErrTransactionInactive   = errors.New("transaction inactive error")
+	ErrReadOnly              = errors.New("read only error")
+	ErrVersion               = errors.New("version error")
+	ErrOperation             = errors.New("operation error")
+	ErrNotAllowed            = errors.New("not allowed error")
+)
+
+// domExceptionErrors maps DOMException names to sentinel errors.
+var domExceptionErrors = map[string]error{
+	"IndexSizeError":             ErrIndexSize,
+	"HierarchyRequestError":      ErrHierarchyRequest,
+	"WrongDocumentError":         ErrWrongDocument,
+	"InvalidCharacterError":      ErrInvalidCharacter,
+	"NoModificationAllowedError": ErrNoModificationAllowed,
+	"NotFoundError":              ErrNotFound,
+	"NotSupportedError":          ErrNotSupported,
+	"InvalidStateError":          ErrInvalidState,
+	"InUseAttributeError":        ErrInUseAttribute,
+	"SyntaxError":                ErrSyntax,
+	"InvalidModificationError":   ErrInvalidModification,
+	"NamespaceError":             ErrNamespace,
+	"InvalidAccessError":         ErrInvalidAccess,
+	"TypeMismatchError":          ErrTypeMismatch,
+	"SecurityError":              ErrSecurity,
+	"NetworkError":               ErrNetwork,
+	"AbortError":                 context.Canceled,
+	"URLMismatchError":           ErrURLMismatch,
+	"QuotaExceededError":         ErrQuotaExceeded,
+	"TimeoutError":               context.DeadlineExceeded,
+	"InvalidNodeTypeError":       ErrInvalidNodeType,
+	"DataCloneError":             ErrDataClone,
+	"EncodingError":              ErrEncoding,
+	"NotReadableError":           ErrNotReadable,
+	"UnknownError":               ErrUnknown,
+	"ConstraintError":            ErrConstraint,
+	"DataError":                  ErrData,
+	"TransactionInactiveError":   ErrTransactionInactive,
+	"ReadOnlyError":              ErrReadOnly,
+	"VersionError":               ErrVersion,
+	"OperationError":             ErrOperation,
+	"NotAllowedError":            ErrNotAllowed,
+}
+
+type domExceptionJS js.Value
+
+// DOMException is a wrapper for the DOMException global interface.
+//
+// https://developer.mozilla.org/en-US/docs/Web/API/DOMException
+var DOMException domExceptionJS
+
+// New wraps the DOMException constructor.
+//
+// https://developer.mozilla.org/en-US/docs/Web/API/DOMException/DOMException
+func (d domExceptionJS) New(message string, name string) DOMExceptionValue {
+	res := js.Value(d).New(message, name)
+	return DOMExceptionValue(res)
+}
+
+var _ (error) = (DOMExceptionValue)(js.Undefined())
+
+// DOMExceptionValue is an instance of DOMException.
+//
+// It matches the sentinel error for its name when used with errors.Is.
+type DOMExceptionValue js.Value
+
+// Code returns the DOMException code property.
+//
+// https://developer.mozilla.org/en-US/docs/Web/API/DOMException/code
+func (d DOMExceptionValue) Code() int {
+	return js.Value(d).Get("code").Int()
+}
+
+// Message returns the DOMException message property.
+//
+// https://developer.mozilla.org/en-US/docs/Web/API/DOMException/message
+func (d DOMExceptionValue) Message() string {
+	return js.Value(d).Get("message").String()
+}
+
+// Name returns the DOMException name property.
+//
+// https://developer.mozilla.org/en-US/docs/Web/API/DOMException/name
+func (d DOMExceptionValue) Name() string {
+	return js.Value(d).Get("name").String()
+}
+
+// Error returns the DOMException name and message.
+func (d DOMExceptionValue) Error() string {
+	return d.Name() + ": " + d.Message()
+}
+
+// Is returns true if the target is the sentinel error for the DOMException
+// name or a DOMExceptionValue referring to the same JS object.
+func (d DOMExceptionValue) Is(target error) bool {
+	if other, ok := target.(DOMExceptionValue); ok {
+		return js.Value(d).Equal(js.Value(other))
+	}
+	sentinel, ok := domExceptionErrors[d.Name()]
+	return ok && sentinel == target
+}
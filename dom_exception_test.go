@@ -0,0 +1,55 @@
+//go:build js
+
+package goji
+
+import (
+	"context"
+	"syscall/js"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDOMExceptionNew(t *testing.T) {
+	err := DOMException.New("test message", "QuotaExceededError")
+	assert.Equal(t, "QuotaExceededError", err.Name())
+	assert.Equal(t, "test message", err.Message())
+	assert.Equal(t, 22, err.Code())
+	assert.Equal(t, "QuotaExceededError: test message", err.Error())
+}
+
+func TestDOMExceptionIs(t *testing.T) {
+	err := DOMException.New("test message", "NotFoundError")
+	assert.ErrorIs(t, err, ErrNotFound)
+	assert.NotErrorIs(t, err, ErrQuotaExceeded)
+}
+
+func TestDOMExceptionAbortError(t *testing.T) {
+	err := DOMException.New("test message", "AbortError")
+	assert.ErrorIs(t, err, context.Canceled)
+}
+
+func TestDOMExceptionAwait(t *testing.T) {
+	value := DOMException.New("test message", "ConstraintError")
+
+	_, err := Await(Promise.Reject(js.Value(value)))
+	require.Error(t, err)
+
+	var exception DOMExceptionValue
+	require.ErrorAs(t, err, &exception)
+	assert.Equal(t, "ConstraintError", exception.Name())
+	assert.ErrorIs(t, err, ErrConstraint)
+}
+
+func TestContextFromSignalAbortError(t *testing.T) {
+	controller := AbortController.New()
+
+	ctx, cancel := ContextFromSignal(controller.Signal())
+	defer cancel()
+
+	controller.Abort(js.Undefined())
+	<-ctx.Done()
+
+	assert.ErrorIs(t, context.Cause(ctx), context.Canceled)
+}
@@ -3,14 +3,35 @@
 package goji
 
 import (
+	"go/token"
 	"reflect"
+	"sync"
 	"syscall/js"
 )
 
 func init() {
 	Error = errorJS(js.Global().Get("Error"))
+	goErrorKey = js.Global().Get("Symbol").Invoke("goji.error")
 }
 
+var (
+	// goErrorKey is the symbol used to store the Go error id on a JS error.
+	goErrorKey js.Value
+	// goErrorRegistry removes Go errors once their JS error is garbage collected.
+	goErrorRegistry = sync.OnceValue(func() FinalizationRegistryValue {
+		return FinalizationRegistry.New(js.FuncOf(func(this js.Value, args []js.Value) any {
+			goErrorsMu.Lock()
+			defer goErrorsMu.Unlock()
+			delete(goErrors, args[0].Int())
+			return js.Undefined()
+		}))
+	})
+	// goErrors contains the Go errors that have been wrapped into JS errors.
+	goErrors   = make(map[int]error)
+	goErrorsID int
+	goErrorsMu sync.Mutex
+)
+
 type errorJS js.Value
 
 // Error is a wrapper for the Error global object.
@@ -36,18 +57,127 @@ type ErrorValue js.Value
 //
 // https://developer.mozilla.org/en-US/docs/Web/JavaScript/Reference/Global_Objects/Error/toString
 func (v ErrorValue) Error() string {
+	if js.Value(v).Type() != js.TypeObject {
+		return js.Global().Call("String", js.Value(v)).String()
+	}
 	res := js.Value(v).Call("toString")
 	return res.String()
 }
 
+// Is returns true if the target is an ErrorValue referring to the same JS object.
+func (v ErrorValue) Is(target error) bool {
+	other, ok := target.(ErrorValue)
+	return ok && js.Value(v).Equal(js.Value(other))
+}
+
+// Unwrap returns the error from the Error cause property.
+//
+// https://developer.mozilla.org/en-US/docs/Web/JavaScript/Reference/Global_Objects/Error/cause
+func (v ErrorValue) Unwrap() error {
+	if js.Value(v).Type() != js.TypeObject {
+		return nil
+	}
+	cause := js.Value(v).Get("cause")
+	if cause.IsUndefined() {
+		return nil
+	}
+	return ErrorFromJS(cause)
+}
+
 // WrapError is a helper func that returns an ErrorValue with the message
 // set to the given error's `Error()` value and the name set to the
 // given error's reflected type name.
+//
+// The errors returned by Unwrap are wrapped into the cause property.
+// The original error is returned when the ErrorValue is passed back into Go
+// through Await, so errors.Is and errors.As keep working after a JS round trip.
+//
+// A nil error is returned as a null ErrorValue.
 func WrapError(err error) ErrorValue {
+	return wrapError(err, false)
+}
+
+// wrapError returns an ErrorValue for the given error.
+//
+// If exportedOnly is true the name is only set for exported error types,
+// so errors such as the ones from errors.New stringify to their message.
+func wrapError(err error, exportedOnly bool) ErrorValue {
+	switch v := err.(type) {
+	case nil:
+		return ErrorValue(js.Null())
+	case ErrorValue:
+		return v
+	case AggregateErrorValue:
+		return ErrorValue(v)
+	case DOMExceptionValue:
+		return ErrorValue(v)
+	}
+
 	wrap := Error.New(err.Error())
-	name := reflect.TypeOf(err).Elem().Name()
-	if name != "" {
+	if name := errorTypeName(err); name != "" && (!exportedOnly || token.IsExported(name)) {
 		js.Value(wrap).Set("name", name)
 	}
+
+	switch u := err.(type) {
+	case interface{ Unwrap() error }:
+		if cause := u.Unwrap(); cause != nil {
+			js.Value(wrap).Set("cause", js.Value(wrapError(cause, exportedOnly)))
+		}
+	case interface{ Unwrap() []error }:
+		causes := make([]any, 0)
+		for _, cause := range u.Unwrap() {
+			if cause != nil {
+				causes = append(causes, js.Value(wrapError(cause, exportedOnly)))
+			}
+		}
+		js.Value(wrap).Set("cause", js.Value(AggregateError.New(js.ValueOf(causes), err.Error())))
+	}
+
+	goErrorsMu.Lock()
+	defer goErrorsMu.Unlock()
+
+	goErrorsID++
+	goErrors[goErrorsID] = err
+
+	js.Global().Get("Object").Call("defineProperty", js.Value(wrap), goErrorKey, map[string]any{"value": goErrorsID})
+	goErrorRegistry().Register(js.Value(wrap), goErrorsID, js.Undefined())
 	return wrap
 }
+
+// errorTypeName returns the name of the given error's type
+// dereferencing pointer types.
+func errorTypeName(err error) string {
+	t := reflect.TypeOf(err)
+	for t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+	return t.Name()
+}
+
+// ErrorFromJS returns the Go error for the given JS value.
+//
+// Errors created by WrapError are returned as the original Go error.
+// JS errors are returned as an ErrorValue, AggregateErrorValue,
+// or DOMExceptionValue.
+func ErrorFromJS(value js.Value) error {
+	if value.Type() != js.TypeObject {
+		return ErrorValue(value)
+	}
+	if id := js.Global().Get("Reflect").Call("get", value, goErrorKey); id.Type() == js.TypeNumber {
+		goErrorsMu.Lock()
+		err, ok := goErrors[id.Int()]
+		goErrorsMu.Unlock()
+
+		if ok {
+			return err
+		}
+	}
+	switch {
+	case value.InstanceOf(js.Value(AggregateError)):
+		return AggregateErrorValue(value)
+	case value.InstanceOf(js.Value(DOMException)):
+		return DOMExceptionValue(value)
+	default:
+		return ErrorValue(value)
+	}
+}
@@ -0,0 +1,136 @@
+// Code generated by gojigen from error_event.webidl. DO NOT EDIT.
+
+//go:build js
+
+package goji
+
+import "syscall/js"
+
+func init() {
+	ErrorEvent = errorEventJS(js.Global().Get("ErrorEvent"))
+}
+
+type errorEventJS js.Value
+
+// ErrorEvent is a wrapper for the ErrorEvent global interface.
+//
+// https://developer.mozilla.org/en-US/docs/Web/API/ErrorEvent
+var ErrorEvent errorEventJS
+
+// New wraps the ErrorEvent constructor.
+//
+// https://developer.mozilla.org/en-US/docs/Web/API/ErrorEvent/ErrorEvent
+func (e errorEventJS) New(eventType string, opts ...eventOption) ErrorEventValue {
+	switch {
+	case len(opts) > 0:
+		options := js.ValueOf(map[string]any{})
+		for _, opt := range opts {
+			opt(options)
+		}
+		res := js.Value(e).New(eventType, options)
+		return ErrorEventValue(res)
+
+	default:
+		res := js.Value(e).New(eventType)
+		return ErrorEventValue(res)
+	}
+}
+
+// ErrorEventValue is an instance of ErrorEvent.
+type ErrorEventValue js.Value
+
+// Colno returns the ErrorEvent colno property.
+//
+// https://developer.mozilla.org/en-US/docs/Web/API/ErrorEvent/colno
+func (e ErrorEventValue) Colno() int {
+	return js.Value(e).Get("colno").Int()
+}
+
+// Error returns the ErrorEvent error property.
+//
+// https://developer.mozilla.org/en-US/docs/Web/API/ErrorEvent/error
+//
+// The error is null if it is not available, such as
+// for errors thrown by cross-origin scripts.
+func (e ErrorEventValue) Error() Nullable[js.Value] {
+	res := js.Value(e).Get("error")
+	return NullableAs[js.Value](res)
+}
+
+// Filename returns the ErrorEvent filename property.
+//
+// https://developer.mozilla.org/en-US/docs/Web/API/ErrorEvent/filename
+func (e ErrorEventValue) Filename() string {
+	return js.Value(e).Get("filename").String()
+}
+
+// Lineno returns the ErrorEvent lineno property.
+//
+// https://developer.mozilla.org/en-US/docs/Web/API/ErrorEvent/lineno
+func (e ErrorEventValue) Lineno() int {
+	return js.Value(e).Get("lineno").Int()
+}
+
+// Message returns the ErrorEvent message property.
+//
+// https://developer.mozilla.org/en-US/docs/Web/API/ErrorEvent/message
+func (e ErrorEventValue) Message() string {
+	return js.Value(e).Get("message").String()
+}
+
+// Event returns the parent Event.
+func (e ErrorEventValue) Event() EventValue {
+	return EventValue(e)
+}
+
+// ErrorEventOptions is used to set ErrorEvent options.
+//
+// The options in EventOptions can also be used with ErrorEvent.
+var ErrorEventOptions = &errorEventOptions{}
+
+type errorEventOptions struct{}
+
+// WithColno sets the colno option.
+//
+// https://developer.mozilla.org/en-US/docs/Web/API/ErrorEvent/ErrorEvent#colno
+func (e errorEventOptions) WithColno(colno int) eventOption {
+	return func(value js.Value) {
+		value.Set("colno", colno)
+	}
+}
+
+// WithError sets the error option.
+//
+// https://developer.mozilla.org/en-US/docs/Web/API/ErrorEvent/ErrorEvent#error
+func (e errorEventOptions) WithError(err js.Value) eventOption {
+	return func(value js.Value) {
+		value.Set("error", err)
+	}
+}
+
+// WithFilename sets the filename option.
+//
+// https://developer.mozilla.org/en-US/docs/Web/API/ErrorEvent/ErrorEvent#filename
+func (e errorEventOptions) WithFilename(filename string) eventOption {
+	return func(value js.Value) {
+		value.Set("filename", filename)
+	}
+}
+
+// WithLineno sets the lineno option.
+//
+// https://developer.mozilla.org/en-US/docs/Web/API/ErrorEvent/ErrorEvent#lineno
+func (e errorEventOptions) WithLineno(lineno int) eventOption {
+	return func(value js.Value) {
+		value.Set("lineno", lineno)
+	}
+}
+
+// WithMessage sets the message option.
+//
+// https://developer.mozilla.org/en-US/docs/Web/API/ErrorEvent/ErrorEvent#message
+func (e errorEventOptions) WithMessage(message string) eventOption {
+	return func(value js.Value) {
+		value.Set("message", message)
+	}
+}
@@ -0,0 +1,31 @@
+//go:build js
+
+package goji
+
+import (
+	"syscall/js"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestErrorEventNew(t *testing.T) {
+	if js.Global().Get("ErrorEvent").IsUndefined() {
+		t.Skip("ErrorEvent is not supported")
+	}
+
+	err := js.Value(Error.New("failed"))
+	event := ErrorEvent.New("error",
+		ErrorEventOptions.WithMessage("failed"),
+		ErrorEventOptions.WithFilename("main.js"),
+		ErrorEventOptions.WithLineno(10),
+		ErrorEventOptions.WithColno(5),
+		ErrorEventOptions.WithError(err),
+	)
+	assert.Equal(t, "error", event.Event().Type())
+	assert.Equal(t, "failed", event.Message())
+	assert.Equal(t, "main.js", event.Filename())
+	assert.Equal(t, 10, event.Lineno())
+	assert.Equal(t, 5, event.Colno())
+	assert.True(t, event.Error().Value.Equal(err))
+}
@@ -3,11 +3,13 @@
 package goji
 
 import (
+	"errors"
 	"fmt"
 	"syscall/js"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 var _ (error) = (*CustomError)(nil)
@@ -30,9 +32,82 @@ func TestWrapError(t *testing.T) {
 	assert.Equal(t, "errorString", js.Value(wrapped).Get("name").String())
 }
 
+func TestWrapErrorWithWrappedError(t *testing.T) {
+	err := fmt.Errorf("outer: %w", &CustomError{})
+	wrapped := WrapError(err)
+	assert.Equal(t, err.Error(), js.Value(wrapped).Get("message").String())
+	assert.Equal(t, "CustomError", js.Value(wrapped).Get("cause").Get("name").String())
+}
+
+func TestWrapErrorNil(t *testing.T) {
+	wrapped := WrapError(nil)
+	assert.True(t, js.Value(wrapped).IsNull())
+}
+
 func TestWrapErrorWithCustomError(t *testing.T) {
 	err := &CustomError{}
 	wrapped := WrapError(err)
 	assert.Equal(t, fmt.Sprintf("CustomError: %s", err.Error()), wrapped.Error())
 	assert.Equal(t, "CustomError", js.Value(wrapped).Get("name").String())
 }
+
+var errSentinel = errors.New("sentinel error")
+
+func TestWrapErrorWithValueError(t *testing.T) {
+	err := CustomError{}
+	wrapped := WrapError(err)
+	assert.Equal(t, fmt.Sprintf("CustomError: %s", err.Error()), wrapped.Error())
+}
+
+func TestWrapErrorCause(t *testing.T) {
+	err := fmt.Errorf("outer: %w", errSentinel)
+	wrapped := js.Value(WrapError(err))
+	assert.Equal(t, "outer: sentinel error", wrapped.Get("message").String())
+	assert.Equal(t, "sentinel error", wrapped.Get("cause").Get("message").String())
+}
+
+func TestWrapErrorRoundTrip(t *testing.T) {
+	err := fmt.Errorf("outer: %w", &CustomError{})
+	res := ErrorFromJS(js.Value(WrapError(err)))
+	assert.ErrorIs(t, res, err)
+
+	var custom *CustomError
+	assert.ErrorAs(t, res, &custom)
+}
+
+func TestWrapErrorAsyncRoundTrip(t *testing.T) {
+	fn := Async(func(this js.Value, args []js.Value) (js.Value, error) {
+		return js.Undefined(), fmt.Errorf("outer: %w", errSentinel)
+	})
+	defer fn.Release()
+
+	_, err := Await(PromiseValue(fn.Invoke()))
+	assert.ErrorIs(t, err, errSentinel)
+}
+
+func TestErrorFromJSCause(t *testing.T) {
+	cause := Error.New("cause")
+	value := js.Global().Get("Error").New("outer", map[string]any{"cause": js.Value(cause)})
+
+	err := ErrorFromJS(value)
+	assert.Equal(t, "Error: outer", err.Error())
+	assert.ErrorIs(t, err, cause)
+}
+
+func TestErrorFromJSPrimitive(t *testing.T) {
+	err := ErrorFromJS(js.ValueOf("test reason"))
+	assert.Equal(t, "test reason", err.Error())
+	assert.Nil(t, errors.Unwrap(err))
+}
+
+func TestWrapErrorAsyncString(t *testing.T) {
+	fn := Async(func(this js.Value, args []js.Value) (js.Value, error) {
+		return js.Undefined(), errors.New("failed")
+	})
+	defer fn.Release()
+
+	toString := js.Global().Call("eval", `(p) => p.catch((err) => String(err))`)
+	res, err := Await(PromiseValue(toString.Invoke(fn.Invoke())))
+	require.NoError(t, err)
+	assert.Equal(t, "failed", res[0].String())
+}
@@ -103,7 +103,7 @@ func (e eventListenerOptions) WithSignal(signal js.Value) eventListenerOption {
 // EventListener returns a new event listener callback
 // that calls the given func when an event is received.
 func EventListener(fn func(event EventValue)) js.Func {
-	return js.FuncOf(func(this js.Value, args []js.Value) any {
+	return FuncOf(func(this js.Value, args []js.Value) any {
 		event := EventValue(args[0])
 		fn(event)
 		return js.Undefined()
@@ -29,3 +29,12 @@ func TestEventConstructorWithOptions(t *testing.T) {
 	assert.True(t, event.Cancelable())
 	assert.True(t, event.Composed())
 }
+
+func TestEventTargetNotDispatched(t *testing.T) {
+	event := Event.New("test")
+
+	_, ok := event.Target().Get()
+	assert.False(t, ok)
+	_, ok = event.CurrentTarget().Get()
+	assert.False(t, ok)
+}
@@ -0,0 +1,129 @@
+//go:build js
+
+package goji
+
+import (
+	"context"
+	"sync"
+	"syscall/js"
+)
+
+// OverflowPolicy determines what happens when an event
+// is received and the events channel buffer is full.
+type OverflowPolicy int
+
+const (
+	// OverflowDrop drops the event when the buffer is full.
+	OverflowDrop OverflowPolicy = iota
+	// OverflowBlock blocks the event listener until the event is
+	// received or the context is done.
+	//
+	// Blocking the event listener also blocks the JS event loop,
+	// so the receiver must not wait on any JS callbacks.
+	OverflowBlock
+)
+
+// EventsOptions is used to set events channel options.
+var EventsOptions = &eventsOptions{}
+
+type eventsOptions struct{}
+
+type eventsOption func(config *eventsConfig)
+
+// eventsConfig contains the options for an events channel.
+type eventsConfig struct {
+	overflow  OverflowPolicy
+	listeners []eventListenerOption
+}
+
+// WithOverflowPolicy sets the policy used when the buffer is full.
+//
+// The default policy is OverflowDrop.
+func (e eventsOptions) WithOverflowPolicy(policy OverflowPolicy) eventsOption {
+	return func(config *eventsConfig) {
+		config.overflow = policy
+	}
+}
+
+// WithListenerOptions sets the options used to add the event listener.
+func (e eventsOptions) WithListenerOptions(opts ...eventListenerOption) eventsOption {
+	return func(config *eventsConfig) {
+		config.listeners = append(config.listeners, opts...)
+	}
+}
+
+// Events returns a channel that receives the events of the given type
+// dispatched to the given target.
+//
+// The event listener is removed and the channel is closed when the context is done.
+func Events(ctx context.Context, target EventTargetValue, eventType string, bufferSize int, opts ...eventsOption) <-chan EventValue {
+	var config eventsConfig
+	for _, opt := range opts {
+		opt(&config)
+	}
+
+	// the lock prevents sending on the
+	// channel after it has been closed
+	var mu sync.Mutex
+	var closed bool
+
+	out := make(chan EventValue, bufferSize)
+	sub := target.Subscribe(eventType, func(event EventValue) {
+		mu.Lock()
+		defer mu.Unlock()
+
+		if closed {
+			return
+		}
+		switch config.overflow {
+		case OverflowBlock:
+			select {
+			case out <- event:
+			case <-ctx.Done():
+			}
+
+		default:
+			select {
+			case out <- event:
+			default:
+			}
+		}
+	}, config.listeners...)
+
+	context.AfterFunc(ctx, func() {
+		sub.Close()
+
+		mu.Lock()
+		defer mu.Unlock()
+
+		closed = true
+		close(out)
+	})
+	return out
+}
+
+// WaitForEvent waits for the first event of any of the given types
+// to be dispatched to the given target and returns it.
+//
+// An error is returned if the context is done before an event is received.
+func WaitForEvent(ctx context.Context, target EventTargetValue, eventTypes ...string) (EventValue, error) {
+	// the channel is buffered so that events received
+	// after the first event never block the listener
+	res := make(chan EventValue, 1)
+	for _, eventType := range eventTypes {
+		sub := target.Subscribe(eventType, func(event EventValue) {
+			select {
+			case res <- event:
+			default:
+			}
+		})
+		defer sub.Close()
+	}
+
+	select {
+	case <-ctx.Done():
+		return EventValue(js.Undefined()), ctx.Err()
+	case event := <-res:
+		return event, nil
+	}
+}
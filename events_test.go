@@ -0,0 +1,112 @@
+//go:build js
+
+package goji
+
+import (
+	"context"
+	"syscall/js"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEvents(t *testing.T) {
+	CheckLeaks(t)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	target := EventTarget.New()
+
+	events := Events(ctx, target, "test", 2)
+	target.DispatchEvent(js.Value(CustomEvent.New("test", js.ValueOf(1))))
+	target.DispatchEvent(js.Value(CustomEvent.New("test", js.ValueOf(2))))
+
+	// the buffer is full so the event is dropped
+	target.DispatchEvent(js.Value(CustomEvent.New("test", js.ValueOf(3))))
+
+	event := <-events
+	assert.Equal(t, 1, CustomEventValue(event).Detail().Int())
+
+	event = <-events
+	assert.Equal(t, 2, CustomEventValue(event).Detail().Int())
+
+	cancel()
+	_, ok := <-events
+	assert.False(t, ok)
+}
+
+func TestEventsBlock(t *testing.T) {
+	CheckLeaks(t)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	target := EventTarget.New()
+
+	events := Events(ctx, target, "test", 0, EventsOptions.WithOverflowPolicy(OverflowBlock))
+
+	received := make(chan int)
+	go func() {
+		defer close(received)
+		for i := 0; i < 3; i++ {
+			event := <-events
+			received <- CustomEventValue(event).Detail().Int()
+		}
+	}()
+
+	go func() {
+		for i := 1; i <= 3; i++ {
+			target.DispatchEvent(js.Value(CustomEvent.New("test", js.ValueOf(i))))
+		}
+	}()
+
+	var actual []int
+	for v := range received {
+		actual = append(actual, v)
+	}
+	assert.Equal(t, []int{1, 2, 3}, actual)
+
+	cancel()
+	_, ok := <-events
+	assert.False(t, ok)
+}
+
+func TestEventsBlockCancel(t *testing.T) {
+	CheckLeaks(t)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	target := EventTarget.New()
+
+	events := Events(ctx, target, "test", 0, EventsOptions.WithOverflowPolicy(OverflowBlock))
+
+	// cancelling the context unblocks the listener
+	time.AfterFunc(10*time.Millisecond, cancel)
+	target.DispatchEvent(js.Value(Event.New("test")))
+
+	_, ok := <-events
+	assert.False(t, ok)
+}
+
+func TestWaitForEvent(t *testing.T) {
+	CheckLeaks(t)
+
+	target := EventTarget.New()
+	go func() {
+		target.DispatchEvent(js.Value(Event.New("other")))
+		target.DispatchEvent(js.Value(Event.New("error")))
+		target.DispatchEvent(js.Value(Event.New("success")))
+	}()
+
+	event, err := WaitForEvent(context.Background(), target, "success", "error")
+	require.NoError(t, err)
+	assert.Equal(t, "error", event.Type())
+}
+
+func TestWaitForEventContext(t *testing.T) {
+	CheckLeaks(t)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+
+	_, err := WaitForEvent(ctx, EventTarget.New(), "test")
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+}
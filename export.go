@@ -0,0 +1,207 @@
+//go:build js
+
+package goji
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"syscall/js"
+	"unicode"
+)
+
+var (
+	contextType = reflect.TypeOf((*context.Context)(nil)).Elem()
+	errorType   = reflect.TypeOf((*error)(nil)).Elem()
+)
+
+// Export returns a JS value that exposes the given Go value to JS.
+//
+// If the given value is a func, a JS function that calls it is returned.
+// Otherwise a JS object is returned with a function for each exported
+// method of the value. Method names are converted to lowerCamelCase, so
+// a method named GetUser is exported as getUser.
+//
+// Arguments are decoded with UnmarshalJS and results are encoded
+// with MarshalJS. Funcs with more than one result, not counting a
+// trailing error, return an array of the results. A func that takes
+// a context.Context as its first parameter or returns an error as its
+// last result returns a promise that resolves with the results or
+// rejects with the error. If such a func takes a context and is called
+// with an AbortSignal after its last parameter, the context is cancelled
+// when the signal is aborted. Other funcs throw if an argument cannot
+// be decoded.
+//
+// The func backing each exported function is released once that
+// function is garbage collected, so a method read from the returned
+// object can still be called after the object is garbage collected.
+//
+// Panics in exported funcs are reported to the handler set by
+// SetPanicHandler and are thrown or rejected as a JS Error named GoPanic.
+func Export(obj any) js.Value {
+	rv := reflect.ValueOf(obj)
+	if !rv.IsValid() {
+		return js.Null()
+	}
+	if rv.Kind() == reflect.Func {
+		fn := js.FuncOf(exportFunc(rv))
+		res := throwingFunc(fn)
+		releaseWith(res, fn)
+		return res
+	}
+	object := js.Global().Get("Object").New()
+	for i := 0; i < rv.NumMethod(); i++ {
+		name := exportName(rv.Type().Method(i).Name)
+		// each method owns its func so that a detached
+		// method can outlive the object it was read from
+		fn := js.FuncOf(exportFunc(rv.Method(i)))
+		res := throwingFunc(fn)
+		releaseWith(res, fn)
+		object.Set(name, res)
+	}
+	return object
+}
+
+// ExportGlobal exports the given Go value and sets
+// it as a global JS value with the given name.
+//
+// See the Export helper function for more details.
+func ExportGlobal(name string, obj any) js.Value {
+	res := Export(obj)
+	js.Global().Set(name, res)
+	return res
+}
+
+// exportFunc returns a func that calls the given func with
+// the decoded JS arguments and returns the encoded results.
+//
+// The returned func must be wrapped with throwingFunc.
+func exportFunc(fn reflect.Value) func(this js.Value, args []js.Value) any {
+	ft := fn.Type()
+	takesContext := ft.NumIn() > 0 && ft.In(0) == contextType
+	returnsError := ft.NumOut() > 0 && ft.Out(ft.NumOut()-1) == errorType
+
+	if !takesContext && !returnsError {
+		return func(this js.Value, args []js.Value) (out any) {
+			defer recoverPanic(func(value js.Value) {
+				out = []any{js.Undefined(), value}
+			})
+			res, err := callExported(context.Background(), fn, args)
+			if err != nil {
+				return []any{js.Undefined(), js.Value(WrapError(err))}
+			}
+			return []any{res, js.Null()}
+		}
+	}
+
+	return func(this js.Value, args []js.Value) any {
+		prom := PromiseOf(func(resolve, reject func(value js.Value)) {
+			ctx := context.Background()
+			if takesContext && hasSignal(ft, args) {
+				signal := AbortSignalValue(args[len(args)-1])
+				args = args[:len(args)-1]
+
+				var cancel context.CancelFunc
+				ctx, cancel = ContextFromSignal(signal)
+				defer cancel()
+			}
+			res, err := callExported(ctx, fn, args)
+			if err != nil {
+				reject(js.Value(WrapError(err)))
+			} else {
+				resolve(res)
+			}
+		})
+		return []any{js.Value(prom), js.Null()}
+	}
+}
+
+// hasSignal returns true if the given arguments end
+// with an AbortSignal that is not a parameter of a
+// func of the given type that takes a context.
+func hasSignal(ft reflect.Type, args []js.Value) bool {
+	if len(args) == 0 || !args[len(args)-1].InstanceOf(js.Value(AbortSignal)) {
+		return false
+	}
+	return ft.IsVariadic() || len(args) > ft.NumIn()-1
+}
+
+// callExported calls the given func with the given context and decoded
+// JS arguments and returns the encoded results or the returned error.
+func callExported(ctx context.Context, fn reflect.Value, args []js.Value) (js.Value, error) {
+	ft := fn.Type()
+	in := make([]reflect.Value, 0, ft.NumIn())
+
+	offset := 0
+	if ft.NumIn() > 0 && ft.In(0) == contextType {
+		in = append(in, reflect.ValueOf(&ctx).Elem())
+		offset = 1
+	}
+	for i := offset; i < ft.NumIn(); i++ {
+		if ft.IsVariadic() && i == ft.NumIn()-1 {
+			for j := i - offset; j < len(args); j++ {
+				v := reflect.New(ft.In(i).Elem())
+				if err := UnmarshalJS(args[j], v.Interface()); err != nil {
+					return js.Undefined(), fmt.Errorf("argument %d: %w", j, err)
+				}
+				in = append(in, v.Elem())
+			}
+			break
+		}
+		arg := js.Undefined()
+		if i-offset < len(args) {
+			arg = args[i-offset]
+		}
+		v := reflect.New(ft.In(i))
+		if err := UnmarshalJS(arg, v.Interface()); err != nil {
+			return js.Undefined(), fmt.Errorf("argument %d: %w", i-offset, err)
+		}
+		in = append(in, v.Elem())
+	}
+
+	out := fn.Call(in)
+	if ft.NumOut() > 0 && ft.Out(ft.NumOut()-1) == errorType {
+		if err := out[len(out)-1]; !err.IsNil() {
+			return js.Undefined(), err.Interface().(error)
+		}
+		out = out[:len(out)-1]
+	}
+
+	switch len(out) {
+	case 0:
+		return js.Undefined(), nil
+
+	case 1:
+		return MarshalJS(out[0].Interface())
+
+	default:
+		res := make([]any, len(out))
+		for i, v := range out {
+			value, err := MarshalJS(v.Interface())
+			if err != nil {
+				return js.Undefined(), err
+			}
+			res[i] = value
+		}
+		return js.ValueOf(res), nil
+	}
+}
+
+// exportName returns the lowerCamelCase JS name of the given Go name.
+//
+// A leading initialism is lowered as a whole, so
+// ID becomes id and HTTPClient becomes httpClient.
+func exportName(name string) string {
+	runes := []rune(name)
+	n := 0
+	for n < len(runes) && unicode.IsUpper(runes[n]) {
+		n++
+	}
+	if n > 1 && n < len(runes) && unicode.IsLower(runes[n]) {
+		n--
+	}
+	for i := 0; i < n; i++ {
+		runes[i] = unicode.ToLower(runes[i])
+	}
+	return string(runes)
+}
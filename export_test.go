@@ -0,0 +1,210 @@
+//go:build js
+
+package goji
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"syscall/js"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type exportUser struct {
+	Name string `json:"name"`
+	Age  int    `json:"age"`
+}
+
+type exportService struct {
+	users map[string]exportUser
+}
+
+func (s *exportService) AddUser(user exportUser) {
+	s.users[user.Name] = user
+}
+
+func (s *exportService) GetUser(name string) (exportUser, error) {
+	user, ok := s.users[name]
+	if !ok {
+		return exportUser{}, fmt.Errorf("user %s not found", name)
+	}
+	return user, nil
+}
+
+func (s *exportService) Wait(ctx context.Context) error {
+	<-ctx.Done()
+	return context.Cause(ctx)
+}
+
+func (s *exportService) Sum(values ...int) int {
+	var sum int
+	for _, v := range values {
+		sum += v
+	}
+	return sum
+}
+
+func (s *exportService) HTTPStatus() (int, string) {
+	return 200, "OK"
+}
+
+func (s *exportService) unexported() {}
+
+func TestExport(t *testing.T) {
+	service := &exportService{users: make(map[string]exportUser)}
+	object := Export(service)
+
+	assert.Equal(t, js.TypeFunction, object.Get("addUser").Type())
+	assert.Equal(t, js.TypeFunction, object.Get("getUser").Type())
+	assert.True(t, object.Get("unexported").IsUndefined())
+
+	user := js.ValueOf(map[string]any{"name": "bob", "age": 30})
+	res := object.Call("addUser", user)
+	assert.True(t, res.IsUndefined())
+	assert.Equal(t, exportUser{Name: "bob", Age: 30}, service.users["bob"])
+
+	out, err := Await(PromiseValue(object.Call("getUser", "bob")))
+	require.NoError(t, err)
+	assert.Equal(t, "bob", out[0].Get("name").String())
+	assert.Equal(t, 30, out[0].Get("age").Int())
+
+	_, err = Await(PromiseValue(object.Call("getUser", "alice")))
+	assert.ErrorContains(t, err, "user alice not found")
+}
+
+func TestExportVariadic(t *testing.T) {
+	object := Export(&exportService{})
+
+	res := object.Call("sum", 1, 2, 3)
+	assert.Equal(t, 6, res.Int())
+
+	res = object.Call("sum")
+	assert.Equal(t, 0, res.Int())
+}
+
+func TestExportMultipleResults(t *testing.T) {
+	object := Export(&exportService{})
+
+	res := object.Call("httpStatus")
+	assert.Equal(t, 200, res.Index(0).Int())
+	assert.Equal(t, "OK", res.Index(1).String())
+}
+
+func TestExportDecodeError(t *testing.T) {
+	object := Export(&exportService{users: make(map[string]exportUser)})
+
+	call := js.Global().Call("eval", `(fn) => {
+		try {
+			fn("invalid");
+		} catch (err) {
+			return err.message;
+		}
+	}`)
+
+	res := call.Invoke(object.Get("addUser").Call("bind", object))
+	assert.Contains(t, res.String(), "argument 0")
+}
+
+func TestExportAbortSignal(t *testing.T) {
+	object := Export(&exportService{})
+
+	controller := AbortController.New()
+	prom := object.Call("wait", js.Value(controller.Signal()))
+	controller.Abort(js.ValueOf("stop"))
+
+	_, err := Await(PromiseValue(prom))
+	assert.ErrorContains(t, err, "stop")
+}
+
+func TestExportFunc(t *testing.T) {
+	fn := Export(func(a, b int) int {
+		return a + b
+	})
+
+	res := fn.Invoke(1, 2)
+	assert.Equal(t, 3, res.Int())
+}
+
+func TestExportFuncPanic(t *testing.T) {
+	fn := Export(func() {
+		panic("boom")
+	})
+
+	call := js.Global().Call("eval", `(fn) => {
+		try {
+			fn();
+		} catch (err) {
+			return err.name;
+		}
+	}`)
+
+	res := call.Invoke(fn)
+	assert.Equal(t, PanicErrorName, res.String())
+}
+
+func TestExportPanicHandler(t *testing.T) {
+	var (
+		recovered any
+		stack     []byte
+	)
+	SetPanicHandler(func(value any, s []byte) {
+		recovered = value
+		stack = s
+	})
+	defer SetPanicHandler(nil)
+
+	fn := Export(func() {
+		panic("exported panic")
+	})
+
+	call := js.Global().Call("eval", `(fn) => {
+		try {
+			fn();
+		} catch (err) {
+			return err.name;
+		}
+	}`)
+
+	res := call.Invoke(fn)
+	assert.Equal(t, PanicErrorName, res.String())
+	assert.Equal(t, "exported panic", recovered)
+	assert.Contains(t, string(stack), "TestExportPanicHandler")
+}
+
+func TestExportDetachedMethod(t *testing.T) {
+	object := Export(&exportService{})
+
+	// the method owns its func and does not depend on the object
+	sum := object.Get("sum")
+	object = js.Undefined()
+
+	res := sum.Invoke(1, 2)
+	assert.Equal(t, 3, res.Int())
+}
+
+func TestExportFuncError(t *testing.T) {
+	fn := Export(func() error {
+		return errors.New("failed")
+	})
+
+	_, err := Await(PromiseValue(fn.Invoke()))
+	assert.ErrorContains(t, err, "failed")
+}
+
+func TestExportGlobal(t *testing.T) {
+	ExportGlobal("gojiExportTest", &exportService{})
+	defer js.Global().Delete("gojiExportTest")
+
+	res := js.Global().Call("eval", `gojiExportTest.sum(1, 2)`)
+	assert.Equal(t, 3, res.Int())
+}
+
+func TestExportName(t *testing.T) {
+	assert.Equal(t, "getUser", exportName("GetUser"))
+	assert.Equal(t, "id", exportName("ID"))
+	assert.Equal(t, "httpClient", exportName("HTTPClient"))
+	assert.Equal(t, "a", exportName("A"))
+}
@@ -0,0 +1,41 @@
+//go:build js
+
+package goji
+
+import "syscall/js"
+
+func init() {
+	FinalizationRegistry = finalizationRegistryJS(js.Global().Get("FinalizationRegistry"))
+}
+
+type finalizationRegistryJS js.Value
+
+// FinalizationRegistry is a wrapper for the FinalizationRegistry global object.
+//
+// https://developer.mozilla.org/en-US/docs/Web/JavaScript/Reference/Global_Objects/FinalizationRegistry
+var FinalizationRegistry finalizationRegistryJS
+
+// FinalizationRegistryValue is an instance of FinalizationRegistry.
+type FinalizationRegistryValue js.Value
+
+// New wraps the FinalizationRegistry constructor.
+//
+// https://developer.mozilla.org/en-US/docs/Web/JavaScript/Reference/Global_Objects/FinalizationRegistry/FinalizationRegistry
+func (f finalizationRegistryJS) New(callbackFn js.Func) FinalizationRegistryValue {
+	res := js.Value(f).New(callbackFn)
+	return FinalizationRegistryValue(res)
+}
+
+// Register wraps the FinalizationRegistry register instance method.
+//
+// https://developer.mozilla.org/en-US/docs/Web/JavaScript/Reference/Global_Objects/FinalizationRegistry/register
+func (f FinalizationRegistryValue) Register(target js.Value, heldValue any, unregisterToken js.Value) {
+	js.Value(f).Call("register", target, heldValue, unregisterToken)
+}
+
+// Unregister wraps the FinalizationRegistry unregister instance method.
+//
+// https://developer.mozilla.org/en-US/docs/Web/JavaScript/Reference/Global_Objects/FinalizationRegistry/unregister
+func (f FinalizationRegistryValue) Unregister(unregisterToken js.Value) bool {
+	return js.Value(f).Call("unregister", unregisterToken).Bool()
+}
@@ -0,0 +1,25 @@
+//go:build js
+
+package goji
+
+import (
+	"syscall/js"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFinalizationRegistryUnregister(t *testing.T) {
+	callback := js.FuncOf(func(this js.Value, args []js.Value) any {
+		return js.Undefined()
+	})
+	defer callback.Release()
+
+	registry := FinalizationRegistry.New(callback)
+	target := js.Global().Get("Object").New()
+	token := js.Global().Get("Object").New()
+
+	registry.Register(target, 1, token)
+	assert.True(t, registry.Unregister(token))
+	assert.False(t, registry.Unregister(token))
+}
@@ -0,0 +1,181 @@
+//go:build js
+
+package goji
+
+import (
+	"runtime/debug"
+	"sync"
+	"syscall/js"
+)
+
+func init() {
+	funcIDs = js.Global().Get("WeakMap").New()
+}
+
+var (
+	// funcIDs maps each tracked JS function to its tracking id.
+	funcIDs js.Value
+	// funcs contains the state used to track funcs.
+	funcs = struct {
+		sync.Mutex
+		// checks is the number of active leak checks.
+		checks int
+		// id is the last assigned tracking id.
+		id int
+		// live contains the creation stack of each unreleased tracked func.
+		live map[int][]byte
+	}{live: make(map[int][]byte)}
+)
+
+// FuncOf returns a new func that is tracked while a leak check is active.
+//
+// Funcs created with FuncOf must be released with ReleaseFunc. Calling
+// js.Func Release directly is not seen by the leak check, so the func is
+// still reported as leaked. All of the helpers in this module that return
+// a func use FuncOf.
+//
+// See js.FuncOf for more details.
+func FuncOf(fn func(this js.Value, args []js.Value) any) js.Func {
+	res := js.FuncOf(fn)
+
+	funcs.Lock()
+	defer funcs.Unlock()
+
+	if funcs.checks == 0 {
+		return res
+	}
+	funcs.id++
+	funcs.live[funcs.id] = debug.Stack()
+	funcIDs.Call("set", res.Value, funcs.id)
+	return res
+}
+
+// ReleaseFunc releases the given func and stops tracking it.
+//
+// See js.Func Release for more details.
+func ReleaseFunc(fn js.Func) {
+	funcs.Lock()
+	if len(funcs.live) > 0 {
+		if id := funcIDs.Call("get", fn.Value); !id.IsUndefined() {
+			funcIDs.Call("delete", fn.Value)
+			delete(funcs.live, id.Int())
+		}
+	}
+	funcs.Unlock()
+
+	fn.Release()
+}
+
+// TestingT is the subset of testing.TB used by CheckLeaks.
+type TestingT interface {
+	Helper()
+	Errorf(format string, args ...any)
+	Cleanup(func())
+}
+
+// CheckLeaks enables func tracking and fails the test if any func created
+// with FuncOf during the test has not been released with ReleaseFunc
+// by the time the test and its subtests complete.
+//
+// Calling js.Func Release directly is not seen by CheckLeaks.
+//
+// The creation stack of each leaked func is included in the failure message.
+// Funcs created by FuncOfAuto are released by the garbage collector and are not tracked.
+// CheckLeaks must not be used in parallel tests.
+func CheckLeaks(t TestingT) {
+	t.Helper()
+
+	funcs.Lock()
+	funcs.checks++
+	start := funcs.id
+	funcs.Unlock()
+
+	t.Cleanup(func() {
+		t.Helper()
+
+		funcs.Lock()
+		defer funcs.Unlock()
+
+		funcs.checks--
+		for id, stack := range funcs.live {
+			if id <= start {
+				continue
+			}
+			t.Errorf("goji: func was not released, created at:\n%s", stack)
+			delete(funcs.live, id)
+		}
+	})
+}
+
+var (
+	// autoFuncRegistry runs cleanups once their owner is garbage collected.
+	autoFuncRegistry = sync.OnceValue(func() FinalizationRegistryValue {
+		return FinalizationRegistry.New(js.FuncOf(func(this js.Value, args []js.Value) any {
+			autoFuncsMu.Lock()
+			cleanup, ok := autoFuncs[args[0].Int()]
+			delete(autoFuncs, args[0].Int())
+			autoFuncsMu.Unlock()
+
+			if ok {
+				cleanup()
+			}
+			return js.Undefined()
+		}))
+	})
+	// autoFuncs contains the cleanups that are waiting for their owner to be garbage collected.
+	autoFuncs   = make(map[int]func())
+	autoFuncsID int
+	autoFuncsMu sync.Mutex
+)
+
+// FuncOfAuto returns a new func that is released
+// once the given owner object is garbage collected.
+//
+// The owner is typically the JS object that holds the func, such as an
+// iterator object holding its next method. The given func must not
+// reference the owner, otherwise the owner is never garbage collected.
+//
+// See js.FuncOf for more details.
+func FuncOfAuto(owner js.Value, fn func(this js.Value, args []js.Value) any) js.Func {
+	res := js.FuncOf(fn)
+	releaseWith(owner, res)
+	return res
+}
+
+// releaseWith releases the given func once the given owner is garbage collected.
+func releaseWith(owner js.Value, fn js.Func) {
+	cleanupWith(owner, fn.Release)
+}
+
+// cleanupWith calls the given func once the given owner is garbage collected.
+func cleanupWith(owner js.Value, cleanup func()) {
+	autoFuncsMu.Lock()
+	autoFuncsID++
+	id := autoFuncsID
+	autoFuncs[id] = cleanup
+	autoFuncsMu.Unlock()
+
+	autoFuncRegistry().Register(owner, id, js.Undefined())
+}
+
+// throwingFuncWrapper returns the JS function that creates the shims
+// returned by throwingFunc.
+//
+// It is compiled once, so pages with a Content Security Policy
+// must allow 'unsafe-eval' for that single compilation.
+var throwingFuncWrapper = sync.OnceValue(func() js.Value {
+	return js.Global().Get("Function").New("fn", `return function(...args) {
+		const [res, err] = fn.apply(this, args);
+		if (err !== null) throw err;
+		return res;
+	}`)
+})
+
+// throwingFunc wraps the given func, which must return a [result, error]
+// array, into a JS function that throws the error if it is not null.
+//
+// Go funcs cannot throw JS exceptions, so synchronous
+// callbacks that need to fail use this shim instead.
+func throwingFunc(fn js.Func) js.Value {
+	return throwingFuncWrapper().Invoke(fn)
+}
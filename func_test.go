@@ -0,0 +1,167 @@
+//go:build js
+
+package goji
+
+import (
+	"fmt"
+	"runtime"
+	"syscall/js"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFuncOfAuto(t *testing.T) {
+	owner := js.Global().Get("Object").New()
+
+	fn := FuncOfAuto(owner, func(this js.Value, args []js.Value) any {
+		return js.ValueOf(args[0].Int() + 1)
+	})
+	owner.Set("fn", fn)
+	assert.Equal(t, 2, owner.Call("fn", 1).Int())
+
+	autoFuncsMu.Lock()
+	defer autoFuncsMu.Unlock()
+	assert.Contains(t, autoFuncs, autoFuncsID)
+}
+
+func TestFuncOfAutoRelease(t *testing.T) {
+	gc := js.Global().Get("gc")
+	if gc.Type() != js.TypeFunction {
+		t.Skip("garbage collection is not exposed")
+	}
+
+	autoFuncsMu.Lock()
+	count := len(autoFuncs)
+	autoFuncsMu.Unlock()
+
+	FuncOfAuto(js.Global().Get("Object").New(), func(this js.Value, args []js.Value) any {
+		return js.Undefined()
+	})
+
+	assert.Eventually(t, func() bool {
+		// the owner must be released by Go before JS can collect it
+		runtime.GC()
+		gc.Invoke()
+		autoFuncsMu.Lock()
+		defer autoFuncsMu.Unlock()
+		return len(autoFuncs) == count
+	}, 5*time.Second, 10*time.Millisecond)
+}
+
+// leakT is a TestingT used to test CheckLeaks.
+type leakT struct {
+	errors   []string
+	cleanups []func()
+}
+
+func (t *leakT) Helper() {}
+
+func (t *leakT) Errorf(format string, args ...any) {
+	t.errors = append(t.errors, fmt.Sprintf(format, args...))
+}
+
+func (t *leakT) Cleanup(fn func()) {
+	t.cleanups = append(t.cleanups, fn)
+}
+
+func (t *leakT) finish() {
+	for i := len(t.cleanups) - 1; i >= 0; i-- {
+		t.cleanups[i]()
+	}
+}
+
+func TestCheckLeaks(t *testing.T) {
+	lt := &leakT{}
+	CheckLeaks(lt)
+
+	released := FuncOf(func(this js.Value, args []js.Value) any {
+		return js.Undefined()
+	})
+	ReleaseFunc(released)
+
+	leaked := FuncOf(func(this js.Value, args []js.Value) any {
+		return js.Undefined()
+	})
+	defer leaked.Release()
+
+	lt.finish()
+	require.Len(t, lt.errors, 1)
+	assert.Contains(t, lt.errors[0], "TestCheckLeaks")
+}
+
+func TestCheckLeaksEventListenerReleaseFunc(t *testing.T) {
+	lt := &leakT{}
+	CheckLeaks(lt)
+
+	target := EventTarget.New()
+	listener := EventListener(func(event EventValue) {})
+	target.AddEventListener("test", listener.Value)
+	target.RemoveEventListener("test", listener.Value, js.Undefined())
+	ReleaseFunc(listener)
+
+	lt.finish()
+	assert.Empty(t, lt.errors)
+}
+
+func TestCheckLeaksReleaseJSFunc(t *testing.T) {
+	lt := &leakT{}
+	CheckLeaks(lt)
+
+	// calling js.Func Release directly is not seen
+	fn := FuncOf(func(this js.Value, args []js.Value) any {
+		return js.Undefined()
+	})
+	fn.Release()
+
+	lt.finish()
+	assert.Len(t, lt.errors, 1)
+}
+
+func TestCheckLeaksHelpers(t *testing.T) {
+	CheckLeaks(t)
+
+	_, err := Await(PromiseOf(func(resolve, reject func(value js.Value)) {
+		resolve(js.Undefined())
+	}))
+	require.NoError(t, err)
+
+	Array.Of(1, 2).MapFunc(func(value js.Value, index int) any {
+		return value
+	})
+
+	listener := EventListener(func(event EventValue) {})
+	ReleaseFunc(listener)
+
+	scope := NewScope()
+	scope.FuncOf(func(this js.Value, args []js.Value) any {
+		return js.Undefined()
+	})
+	scope.Close()
+}
+
+func TestThrowingFunc(t *testing.T) {
+	fn := js.FuncOf(func(this js.Value, args []js.Value) any {
+		if len(args) == 0 {
+			return []any{js.Undefined(), js.Value(Error.New("failed"))}
+		}
+		return []any{args[0], js.Null()}
+	})
+	defer fn.Release()
+
+	// the shims share a single compiled wrapper
+	require.True(t, throwingFuncWrapper().Equal(throwingFuncWrapper()))
+	shim := throwingFunc(fn)
+
+	call := js.Global().Call("eval", `(fn) => {
+		try {
+			return fn();
+		} catch (err) {
+			return err.message;
+		}
+	}`)
+	assert.Equal(t, "failed", call.Invoke(shim).String())
+	assert.Equal(t, 1, shim.Invoke(1).Int())
+}
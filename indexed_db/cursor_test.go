@@ -3,7 +3,7 @@
 package indexed_db
 
 import (
-	"sync"
+	"context"
 	"syscall/js"
 	"testing"
 
@@ -36,21 +36,18 @@ func TestCursor(t *testing.T) {
 	_, err = Await(store.Add(map[string]any{"name": "alice"}))
 	require.NoError(t, err)
 
-	var cursorWait sync.WaitGroup
-	var cursorReq RequestValue[CursorValue]
-	cursorSuccess := goji.EventListener(func(event goji.EventValue) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	cursorReq := store.OpenCursor()
+	events := goji.Events(ctx, cursorReq.EventTarget(), SuccessEvent, 1)
+
+	// expect 3 iterations
+	for i := 0; i < 3; i++ {
+		<-events
 		value := cursorReq.Result()
 		if !js.Value(value).IsNull() {
 			value.Continue()
 		}
-		cursorWait.Done()
-	})
-	defer cursorSuccess.Release()
-
-	cursorWait.Add(3) // expect 3 iterations
-
-	cursorReq = store.OpenCursor()
-	cursorReq.EventTarget().AddEventListener(SuccessEvent, cursorSuccess.Value)
-
-	cursorWait.Wait()
+	}
 }
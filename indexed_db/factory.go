@@ -48,6 +48,16 @@ func Cmp(first any, second any) int {
 	return indexedDB.Call("cmp", first, second).Int()
 }
 
+// DatabaseInfo contains the name and version of a database.
+//
+// https://developer.mozilla.org/en-US/docs/Web/API/IDBFactory/databases#return_value
+type DatabaseInfo struct {
+	// Name is the name of the database.
+	Name string `json:"name"`
+	// Version is the version of the database.
+	Version int `json:"version"`
+}
+
 // Databases wraps the IDBFactory databases method.
 //
 // https://developer.mozilla.org/en-US/docs/Web/API/IDBFactory/databases
@@ -55,3 +65,9 @@ func Databases() goji.PromiseValue {
 	res := indexedDB.Call("databases")
 	return goji.PromiseValue(res)
 }
+
+// DatabasesTyped is the same as Databases but returns a promise
+// that resolves to a slice of DatabaseInfo.
+func DatabasesTyped() goji.TypedPromise[[]DatabaseInfo] {
+	return goji.TypedPromise[[]DatabaseInfo](Databases())
+}
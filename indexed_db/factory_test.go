@@ -3,6 +3,7 @@
 package indexed_db
 
 import (
+	"context"
 	"testing"
 
 	"github.com/sourcenetwork/goji"
@@ -47,3 +48,21 @@ func TestDelete(t *testing.T) {
 	_, err = Await(deleteReq)
 	require.NoError(t, err)
 }
+
+func TestDatabases(t *testing.T) {
+	upgradeNeeded := goji.EventListener(func(event goji.EventValue) {
+		// do nothing
+	})
+	defer upgradeNeeded.Release()
+
+	request := Open(t.Name(), 1)
+	request.EventTarget().AddEventListener(UpgradeNeededEvent, upgradeNeeded.Value)
+
+	db, err := Await(request)
+	require.NoError(t, err)
+	defer db.Close()
+
+	infos, err := DatabasesTyped().Await(context.Background())
+	require.NoError(t, err)
+	assert.Contains(t, infos, DatabaseInfo{Name: t.Name(), Version: 1})
+}
@@ -4,6 +4,8 @@ package indexed_db
 
 import (
 	"syscall/js"
+
+	"github.com/sourcenetwork/goji"
 )
 
 // IndexValue is an IDBIndex instance.
@@ -14,8 +16,9 @@ type IndexValue js.Value
 // KeyPath returns the IDBIndex keyPath property.
 //
 // https://developer.mozilla.org/en-US/docs/Web/API/IDBIndex/keyPath
-func (i IndexValue) KeyPath() js.Value {
-	return js.Value(i).Get("keyPath")
+func (i IndexValue) KeyPath() goji.Nullable[js.Value] {
+	res := js.Value(i).Get("keyPath")
+	return goji.NullableAs[js.Value](res)
 }
 
 // MultiEntry returns the IDBIndex multiEntry property.
@@ -39,7 +39,7 @@ func TestIndex(t *testing.T) {
 	require.NoError(t, err)
 
 	assert.Equal(t, "authors_age", index.Name())
-	assert.Equal(t, "age", index.KeyPath().String())
+	assert.Equal(t, "age", index.KeyPath().Value.String())
 	assert.Equal(t, false, index.MultiEntry())
 	assert.Equal(t, true, index.Unique())
 
@@ -4,6 +4,8 @@ package indexed_db
 
 import (
 	"syscall/js"
+
+	"github.com/sourcenetwork/goji"
 )
 
 // ObjectStoreValue is an instance of IDBObjectStore.
@@ -26,8 +28,11 @@ func (o ObjectStoreValue) IndexNames() js.Value {
 // KeyPath returns the IDBObjectStore keyPath property.
 //
 // https://developer.mozilla.org/en-US/docs/Web/API/IDBObjectStore/keyPath
-func (o ObjectStoreValue) KeyPath() js.Value {
-	return js.Value(o).Get("keyPath")
+//
+// The key path is null if the object store uses out-of-line keys.
+func (o ObjectStoreValue) KeyPath() goji.Nullable[js.Value] {
+	res := js.Value(o).Get("keyPath")
+	return goji.NullableAs[js.Value](res)
 }
 
 // Name returns the IDBObjectStore name property.
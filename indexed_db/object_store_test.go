@@ -5,6 +5,7 @@ package indexed_db
 import (
 	"syscall/js"
 	"testing"
+	"time"
 
 	"github.com/sourcenetwork/goji"
 
@@ -13,11 +14,13 @@ import (
 )
 
 func TestObjectStoreAdd(t *testing.T) {
+	goji.CheckLeaks(t)
+
 	var req RequestValue[DatabaseValue]
 	upgradeNeeded := goji.EventListener(func(event goji.EventValue) {
 		req.Result().CreateObjectStore("authors")
 	})
-	defer upgradeNeeded.Release()
+	defer goji.ReleaseFunc(upgradeNeeded)
 
 	req = Open(t.Name(), 1)
 	req.EventTarget().AddEventListener(UpgradeNeededEvent, upgradeNeeded.Value)
@@ -42,3 +45,70 @@ func TestObjectStoreAdd(t *testing.T) {
 	require.NoError(t, err)
 	assert.Equal(t, "bob", actualVal.Get("name").String())
 }
+
+func TestObjectStoreAddConstraintError(t *testing.T) {
+	var req RequestValue[DatabaseValue]
+	upgradeNeeded := goji.EventListener(func(event goji.EventValue) {
+		req.Result().CreateObjectStore("authors")
+	})
+	defer upgradeNeeded.Release()
+
+	req = Open(t.Name(), 1)
+	req.EventTarget().AddEventListener(UpgradeNeededEvent, upgradeNeeded.Value)
+
+	db, err := Await(req)
+	require.NoError(t, err)
+	defer db.Close()
+
+	key := js.ValueOf(1)
+	val := js.ValueOf(map[string]any{"name": "bob"})
+
+	transaction := db.Transaction(js.ValueOf("authors"), TransactionModeReadWrite)
+	defer transaction.Abort()
+
+	store := transaction.ObjectStore("authors")
+
+	_, err = Await(store.Add(val, key))
+	require.NoError(t, err)
+
+	_, err = Await(store.Add(val, key))
+	assert.ErrorIs(t, err, goji.ErrConstraint)
+}
+
+func TestObjectStorePutStructuredClone(t *testing.T) {
+	var req RequestValue[DatabaseValue]
+	upgradeNeeded := goji.EventListener(func(event goji.EventValue) {
+		req.Result().CreateObjectStore("authors")
+	})
+	defer upgradeNeeded.Release()
+
+	req = Open(t.Name(), 1)
+	req.EventTarget().AddEventListener(UpgradeNeededEvent, upgradeNeeded.Value)
+
+	db, err := Await(req)
+	require.NoError(t, err)
+	defer db.Close()
+
+	born := time.Date(1970, time.January, 2, 0, 0, 0, 0, time.UTC)
+
+	key := js.ValueOf(1)
+	val := goji.Map.New().
+		Set("name", "bob").
+		Set("born", js.Value(goji.DateFromTime(born)))
+
+	transaction := db.Transaction(js.ValueOf("authors"), TransactionModeReadWrite)
+	defer transaction.Abort()
+
+	store := transaction.ObjectStore("authors")
+
+	_, err = Await(store.Put(js.Value(val), key))
+	require.NoError(t, err)
+
+	actualVal, err := Await(store.Get(key))
+	require.NoError(t, err)
+	require.True(t, actualVal.InstanceOf(js.Value(goji.Map)))
+
+	actual := goji.MapValue(actualVal)
+	assert.Equal(t, "bob", actual.Get("name").String())
+	assert.True(t, born.Equal(goji.DateValue(actual.Get("born")).Time()))
+}
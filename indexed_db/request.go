@@ -3,8 +3,7 @@
 package indexed_db
 
 import (
-	"errors"
-	"sync"
+	"context"
 	"syscall/js"
 
 	"github.com/sourcenetwork/goji"
@@ -18,6 +17,16 @@ const (
 	SuccessEvent = "success"
 )
 
+// The values of the IDBRequest readyState property.
+//
+// https://developer.mozilla.org/en-US/docs/Web/API/IDBRequest/readyState
+const (
+	// The request is pending and its result is not yet available.
+	RequestReadyStatePending = "pending"
+	// The request is done and its result or error is available.
+	RequestReadyStateDone = "done"
+)
+
 // RequestResult is the type union for request results.
 type RequestResult interface {
 	js.Value | DatabaseValue | CursorValue
@@ -29,8 +38,11 @@ type RequestValue[T RequestResult] js.Value
 // Error returns the IDBRequest error property.
 //
 // https://developer.mozilla.org/en-US/docs/Web/API/IDBRequest/error
-func (r RequestValue[T]) Error() js.Value {
-	return js.Value(r).Get("error")
+//
+// The error is null if the request succeeded.
+func (r RequestValue[T]) Error() goji.Nullable[goji.DOMExceptionValue] {
+	res := js.Value(r).Get("error")
+	return goji.NullableAs[goji.DOMExceptionValue](res)
 }
 
 // Result returns the IDBRequest result property.
@@ -44,8 +56,11 @@ func (r RequestValue[T]) Result() T {
 // Source returns the IDBRequest source property.
 //
 // https://developer.mozilla.org/en-US/docs/Web/API/IDBRequest/source
-func (r RequestValue[T]) Source() js.Value {
-	return js.Value(r).Get("source")
+//
+// The source is null for open requests.
+func (r RequestValue[T]) Source() goji.Nullable[js.Value] {
+	res := js.Value(r).Get("source")
+	return goji.NullableAs[js.Value](res)
 }
 
 // ReadyState returns the IDBRequest readyState property.
@@ -58,9 +73,12 @@ func (r RequestValue[T]) ReadyState() string {
 // Transaction returns the IDBRequest transaction property.
 //
 // https://developer.mozilla.org/en-US/docs/Web/API/IDBRequest/transaction
-func (r RequestValue[T]) Transaction() TransactionValue {
+//
+// The transaction is null for open requests
+// outside of an upgrade transaction.
+func (r RequestValue[T]) Transaction() goji.Nullable[TransactionValue] {
 	res := js.Value(r).Get("transaction")
-	return TransactionValue(res)
+	return goji.NullableAs[TransactionValue](res)
 }
 
 // EventTarget returns the EventTarget for the request.
@@ -69,27 +87,27 @@ func (r RequestValue[T]) EventTarget() goji.EventTargetValue {
 }
 
 // Await is a helper that waits for a request and returns the result and error.
-func Await[T RequestResult](request RequestValue[T]) (res T, err error) {
-	var wait sync.WaitGroup
-
-	onSuccess := goji.EventListener(func(event goji.EventValue) {
-		defer wait.Done()
-		res = request.Result()
-	})
-	defer onSuccess.Release()
-
-	onError := goji.EventListener(func(event goji.EventValue) {
-		defer wait.Done()
-		err = errors.New(request.Error().Get("message").String())
-	})
-	defer onError.Release()
-
-	wait.Add(1)
-
-	request.EventTarget().AddEventListener(SuccessEvent, onSuccess.Value)
-	request.EventTarget().AddEventListener(ErrorEvent, onError.Value)
-
-	wait.Wait()
+//
+// Request errors are returned as a goji.DOMExceptionValue.
+func Await[T RequestResult](request RequestValue[T]) (T, error) {
+	return AwaitContext(context.Background(), request)
+}
 
-	return
+// AwaitContext is a helper that waits for a request and returns the result and error.
+//
+// Request errors are returned as a goji.DOMExceptionValue.
+// An error is returned if the context is done before the request completes.
+func AwaitContext[T RequestResult](ctx context.Context, request RequestValue[T]) (T, error) {
+	if request.ReadyState() != RequestReadyStateDone {
+		_, err := goji.WaitForEvent(ctx, request.EventTarget(), SuccessEvent, ErrorEvent)
+		if err != nil {
+			var res T
+			return res, err
+		}
+	}
+	if err, ok := request.Error().Get(); ok {
+		var res T
+		return res, err
+	}
+	return request.Result(), nil
 }
@@ -4,6 +4,8 @@ package indexed_db
 
 import (
 	"syscall/js"
+
+	"github.com/sourcenetwork/goji"
 )
 
 const (
@@ -40,11 +42,14 @@ func (t TransactionValue) Durability() string {
 // Error returns the IDBTransaction error property.
 //
 // https://developer.mozilla.org/en-US/docs/Web/API/IDBTransaction/error
-func (t TransactionValue) Error() js.Value {
-	return js.Value(t).Get("error")
+//
+// The error is null if the transaction has not been aborted.
+func (t TransactionValue) Error() goji.Nullable[goji.DOMExceptionValue] {
+	res := js.Value(t).Get("error")
+	return goji.NullableAs[goji.DOMExceptionValue](res)
 }
 
-// String returns the IDBTransaction mode property.
+// Mode returns the IDBTransaction mode property.
 //
 // https://developer.mozilla.org/en-US/docs/Web/API/IDBTransaction/mode
 func (t TransactionValue) Mode() string {
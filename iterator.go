@@ -0,0 +1,169 @@
+//go:build js
+
+package goji
+
+import (
+	"errors"
+	"fmt"
+	"iter"
+	"syscall/js"
+)
+
+// ErrNotIterable is returned when a value is not iterable.
+var ErrNotIterable = errors.New("value is not iterable")
+
+// Iterate returns an iterator over the values of the given iterable.
+//
+// The iterable can be any value with a Symbol.iterator method, such as
+// an array, a map, or the iterator returned by ArrayValue.Entries.
+// The iteration stops after the first error is yielded, which is
+// ErrNotIterable if the value is not iterable or the value thrown by
+// the JS iterator converted with ErrorFromJS. If the consumer stops
+// early, the return method of the JS iterator is called so that it
+// can release its resources.
+func Iterate(value js.Value) iter.Seq2[js.Value, error] {
+	return func(yield func(js.Value, error) bool) {
+		iterator, err := iteratorOf(value)
+		if err != nil {
+			yield(js.Undefined(), err)
+			return
+		}
+		next := iterator.Get("next")
+		for {
+			res, err := callJS(next, iterator)
+			if err == nil && res.Type() != js.TypeObject {
+				err = fmt.Errorf("iterator result %s is not an object", res.Type())
+			}
+			if err != nil {
+				yield(js.Undefined(), err)
+				return
+			}
+			if res.Get("done").Truthy() {
+				return
+			}
+			if !yield(res.Get("value"), nil) {
+				closeIterator(iterator)
+				return
+			}
+		}
+	}
+}
+
+// Iterate2 returns an iterator over the [key, value] entries
+// of the given iterable, such as a map or the iterator
+// returned by ArrayValue.Entries.
+//
+// Iterate2 panics with the error that Iterate would yield, wrapped
+// so that it can be inspected with errors.Is and errors.As.
+//
+// See the Iterate helper function for more details.
+func Iterate2(value js.Value) iter.Seq2[js.Value, js.Value] {
+	return func(yield func(js.Value, js.Value) bool) {
+		for entry, err := range Iterate(value) {
+			if err != nil {
+				panic(fmt.Errorf("goji: iterate: %w", err))
+			}
+			if !yield(entry.Index(0), entry.Index(1)) {
+				return
+			}
+		}
+	}
+}
+
+// IterableOf returns an iterable object over the values
+// of the given iterator marshalled with MarshalJS.
+//
+// Each call to the Symbol.iterator method of the object starts a new
+// iteration of the given iterator. An iteration is stopped when it is
+// exhausted or its return method is called, which for...of and
+// Array.from always do. An iteration whose next method throws because
+// a value could not be marshalled is also stopped.
+//
+// The funcs backing the iterable are released once the iterable object
+// is garbage collected, and an iteration is stopped once its iterator
+// object is garbage collected.
+func IterableOf[T any](seq iter.Seq[T]) js.Value {
+	object := js.Global().Get("Object").New()
+	symbol := js.Global().Get("Symbol").Get("iterator")
+	iterator := FuncOfAuto(object, func(this js.Value, args []js.Value) any {
+		return iteratorFromPull(iter.Pull(seq))
+	})
+	js.Global().Get("Object").Call("defineProperty", object, symbol, map[string]any{"value": iterator})
+	return object
+}
+
+// iteratorFromPull returns an iterator object that calls the given pull funcs.
+func iteratorFromPull[T any](next func() (T, bool), stop func()) js.Value {
+	object := js.Global().Get("Object").New()
+	nextFn := FuncOfAuto(object, func(this js.Value, args []js.Value) any {
+		v, ok := next()
+		if !ok {
+			return []any{js.ValueOf(map[string]any{"done": true}), js.Null()}
+		}
+		value, err := MarshalJS(v)
+		if err != nil {
+			stop()
+			return []any{js.Undefined(), js.Value(WrapError(err))}
+		}
+		return []any{js.ValueOf(map[string]any{"done": false, "value": value}), js.Null()}
+	})
+	returnFn := FuncOfAuto(object, func(this js.Value, args []js.Value) any {
+		stop()
+		value := js.Undefined()
+		if len(args) > 0 {
+			value = args[0]
+		}
+		return js.ValueOf(map[string]any{"done": true, "value": value})
+	})
+	object.Set("next", throwingFunc(nextFn))
+	object.Set("return", returnFn)
+	// an iterator that is dropped before it is exhausted
+	// or returned must still stop its pull coroutine
+	cleanupWith(object, stop)
+	return object
+}
+
+// iteratorOf returns the iterator of the given iterable
+// or ErrNotIterable if the value is not iterable.
+func iteratorOf(value js.Value) (js.Value, error) {
+	if value.Type() != js.TypeObject && value.Type() != js.TypeFunction && value.Type() != js.TypeString {
+		return js.Undefined(), ErrNotIterable
+	}
+	symbol := js.Global().Get("Symbol").Get("iterator")
+	method := js.Global().Get("Reflect").Call("get", js.Global().Get("Object").Invoke(value), symbol)
+	if method.Type() != js.TypeFunction {
+		return js.Undefined(), ErrNotIterable
+	}
+	res, err := callJS(method, value)
+	if err == nil && res.Type() != js.TypeObject {
+		err = ErrNotIterable
+	}
+	return res, err
+}
+
+// callJS calls the given JS function with the given this value and
+// arguments and returns the thrown value converted with ErrorFromJS
+// instead of panicking.
+func callJS(fn js.Value, this js.Value, args ...any) (res js.Value, err error) {
+	defer func() {
+		value := recover()
+		if value == nil {
+			return
+		}
+		jsErr, ok := value.(js.Error)
+		if !ok {
+			panic(value)
+		}
+		res, err = js.Undefined(), ErrorFromJS(jsErr.Value)
+	}()
+	return fn.Call("call", append([]any{this}, args...)...), nil
+}
+
+// closeIterator calls the return method of the given iterator if it has one.
+func closeIterator(iterator js.Value) {
+	method := iterator.Get("return")
+	if method.Type() != js.TypeFunction {
+		return
+	}
+	method.Call("call", iterator)
+}
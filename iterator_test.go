@@ -0,0 +1,211 @@
+//go:build js
+
+package goji
+
+import (
+	"slices"
+	"syscall/js"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestIterate(t *testing.T) {
+	array := Array.Of(js.ValueOf(1), js.ValueOf(2), js.ValueOf(3))
+
+	var values []int
+	for value, err := range Iterate(js.Value(array)) {
+		require.NoError(t, err)
+		values = append(values, value.Int())
+	}
+	assert.Equal(t, []int{1, 2, 3}, values)
+}
+
+func TestIterateString(t *testing.T) {
+	var values []string
+	for value, err := range Iterate(js.ValueOf("abc")) {
+		require.NoError(t, err)
+		values = append(values, value.String())
+	}
+	assert.Equal(t, []string{"a", "b", "c"}, values)
+}
+
+func TestIterateNotIterable(t *testing.T) {
+	for _, value := range []js.Value{js.ValueOf(1), js.Null(), js.Global().Get("Object").New()} {
+		var errs []error
+		for _, err := range Iterate(value) {
+			errs = append(errs, err)
+		}
+		require.Len(t, errs, 1)
+		assert.ErrorIs(t, errs[0], ErrNotIterable)
+	}
+}
+
+func TestIterateThrow(t *testing.T) {
+	value := js.Global().Call("eval", `(function* () {
+		yield 1;
+		throw new Error("failed");
+	})()`)
+
+	var values []int
+	var errs []error
+	for value, err := range Iterate(value) {
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		values = append(values, value.Int())
+	}
+	assert.Equal(t, []int{1}, values)
+	require.Len(t, errs, 1)
+	assert.ErrorContains(t, errs[0], "failed")
+}
+
+func TestIterateBreak(t *testing.T) {
+	value := js.Global().Call("eval", `(() => {
+		const state = { closed: false };
+		state.iter = (function* () {
+			try {
+				yield 1;
+				yield 2;
+			} finally {
+				state.closed = true;
+			}
+		})();
+		return state;
+	})()`)
+
+	for value, err := range Iterate(value.Get("iter")) {
+		require.NoError(t, err)
+		assert.Equal(t, 1, value.Int())
+		break
+	}
+	assert.True(t, value.Get("closed").Bool())
+}
+
+func TestIterate2(t *testing.T) {
+	m := Map.New()
+	m.Set(js.ValueOf("a"), js.ValueOf(1))
+	m.Set(js.ValueOf("b"), js.ValueOf(2))
+
+	values := make(map[string]int)
+	for key, value := range Iterate2(js.Value(m)) {
+		values[key.String()] = value.Int()
+	}
+	assert.Equal(t, map[string]int{"a": 1, "b": 2}, values)
+}
+
+func TestIterate2ArrayEntries(t *testing.T) {
+	array := Array.Of(js.ValueOf("a"), js.ValueOf("b"))
+
+	var keys []int
+	var values []string
+	for key, value := range Iterate2(array.Entries()) {
+		keys = append(keys, key.Int())
+		values = append(values, value.String())
+	}
+	assert.Equal(t, []int{0, 1}, keys)
+	assert.Equal(t, []string{"a", "b"}, values)
+}
+
+func TestIterate2NotIterable(t *testing.T) {
+	defer func() {
+		err, ok := recover().(error)
+		require.True(t, ok)
+		assert.ErrorIs(t, err, ErrNotIterable)
+	}()
+	for range Iterate2(js.ValueOf(1)) {
+	}
+	t.Fatal("expected a panic")
+}
+
+func TestIterableOfArrayFrom(t *testing.T) {
+	type item struct {
+		Name string `json:"name"`
+	}
+	iterable := IterableOf(slices.Values([]item{{Name: "one"}, {Name: "two"}}))
+
+	res := js.Global().Get("Array").Call("from", iterable)
+	require.Equal(t, 2, res.Length())
+	assert.Equal(t, "one", res.Index(0).Get("name").String())
+	assert.Equal(t, "two", res.Index(1).Get("name").String())
+
+	// each iteration starts from the beginning
+	res = js.Global().Get("Array").Call("from", iterable)
+	assert.Equal(t, 2, res.Length())
+}
+
+func TestIterableOfForOfBreak(t *testing.T) {
+	var stopped bool
+	iterable := IterableOf(func(yield func(int) bool) {
+		defer func() { stopped = true }()
+		for i := 0; ; i++ {
+			if !yield(i) {
+				return
+			}
+		}
+	})
+
+	first := js.Global().Call("eval", `(iter) => {
+		for (const value of iter) {
+			return value;
+		}
+	}`)
+
+	res := first.Invoke(iterable)
+	assert.Equal(t, 0, res.Int())
+	assert.True(t, stopped)
+}
+
+func TestIterableOfDropIterator(t *testing.T) {
+	stopped := make(chan struct{})
+	iterable := IterableOf(func(yield func(int) bool) {
+		defer close(stopped)
+		for i := 0; ; i++ {
+			if !yield(i) {
+				return
+			}
+		}
+	})
+
+	// the iterator is dropped without being exhausted or returned
+	take := js.Global().Call("eval", `(iter) => {
+		const it = iter[Symbol.iterator]();
+		return it.next().value + it.next().value;
+	}`)
+	res := take.Invoke(iterable)
+	assert.Equal(t, 1, res.Int())
+
+	// the last cleanup registered with the finalization registry
+	// is the one that stops the iteration of the dropped iterator
+	autoFuncsMu.Lock()
+	cleanup := autoFuncs[autoFuncsID]
+	delete(autoFuncs, autoFuncsID)
+	autoFuncsMu.Unlock()
+
+	require.NotNil(t, cleanup)
+	cleanup()
+
+	select {
+	case <-stopped:
+	case <-time.After(time.Second):
+		t.Fatal("expected the dropped iterator to be stopped")
+	}
+}
+
+func TestIterableOfMarshalError(t *testing.T) {
+	iterable := IterableOf(slices.Values([]chan int{make(chan int)}))
+
+	collect := js.Global().Call("eval", `(iter) => {
+		try {
+			return Array.from(iter);
+		} catch (err) {
+			return err.message;
+		}
+	}`)
+
+	res := collect.Invoke(iterable)
+	assert.Equal(t, js.TypeString, res.Type())
+}
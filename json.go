@@ -2,10 +2,7 @@
 
 package goji
 
-import (
-	"encoding/json"
-	"syscall/js"
-)
+import "syscall/js"
 
 func init() {
 	JSON = jsonJS(js.Global().Get("JSON"))
@@ -31,35 +28,3 @@ func (j jsonJS) Parse(text string) js.Value {
 func (j jsonJS) Stringify(value js.Value) string {
 	return js.Value(j).Call("stringify", value).String()
 }
-
-// MarshalJS marshals the given value into a js.Value.
-func MarshalJS(v any) (js.Value, error) {
-	data, err := json.Marshal(v)
-	if err != nil {
-		return js.Undefined(), err
-	}
-	return JSON.Parse(string(data)), nil
-}
-
-// UnmarshalJS unmarshals the given js.Value into the given pointer.
-func UnmarshalJS(value js.Value, v any) error {
-	text := JSON.Stringify(value)
-	return json.Unmarshal([]byte(text), v)
-}
-
-// MustMarshalJS marshals the given value into a js.Value or panics.
-func MustMarshalJS(v any) js.Value {
-	value, err := MarshalJS(v)
-	if err != nil {
-		panic(err)
-	}
-	return value
-}
-
-// MustUnmarshalJS unmarshals the given js.Value into the given pointer or panics.
-func MustUnmarshalJS(value js.Value, v any) {
-	err := UnmarshalJS(value, v)
-	if err != nil {
-		panic(err)
-	}
-}
@@ -0,0 +1,106 @@
+//go:build js
+
+package goji
+
+import "syscall/js"
+
+func init() {
+	Map = mapJS(js.Global().Get("Map"))
+}
+
+type mapJS js.Value
+
+// Map is a wrapper for the Map global object.
+//
+// https://developer.mozilla.org/en-US/docs/Web/JavaScript/Reference/Global_Objects/Map
+var Map mapJS
+
+// MapValue is an instance of Map.
+type MapValue js.Value
+
+// New wraps the map constructor.
+//
+// https://developer.mozilla.org/en-US/docs/Web/JavaScript/Reference/Global_Objects/Map/Map
+func (m mapJS) New(iterable ...any) MapValue {
+	res := js.Value(m).New(iterable...)
+	return MapValue(res)
+}
+
+// GroupBy wraps the map groupBy static method.
+//
+// https://developer.mozilla.org/en-US/docs/Web/JavaScript/Reference/Global_Objects/Map/groupBy
+func (m mapJS) GroupBy(items js.Value, callbackFn js.Value) MapValue {
+	res := js.Value(m).Call("groupBy", items, callbackFn)
+	return MapValue(res)
+}
+
+// Size wraps the map size property.
+//
+// https://developer.mozilla.org/en-US/docs/Web/JavaScript/Reference/Global_Objects/Map/size
+func (m MapValue) Size() int {
+	return js.Value(m).Get("size").Int()
+}
+
+// Clear wraps the map clear instance method.
+//
+// https://developer.mozilla.org/en-US/docs/Web/JavaScript/Reference/Global_Objects/Map/clear
+func (m MapValue) Clear() {
+	js.Value(m).Call("clear")
+}
+
+// Delete wraps the map delete instance method.
+//
+// https://developer.mozilla.org/en-US/docs/Web/JavaScript/Reference/Global_Objects/Map/delete
+func (m MapValue) Delete(key any) bool {
+	return js.Value(m).Call("delete", key).Bool()
+}
+
+// Entries wraps the map entries instance method.
+//
+// https://developer.mozilla.org/en-US/docs/Web/JavaScript/Reference/Global_Objects/Map/entries
+func (m MapValue) Entries() js.Value {
+	return js.Value(m).Call("entries")
+}
+
+// ForEach wraps the map forEach instance method.
+//
+// https://developer.mozilla.org/en-US/docs/Web/JavaScript/Reference/Global_Objects/Map/forEach
+func (m MapValue) ForEach(callbackFn js.Value, thisArg js.Value) {
+	js.Value(m).Call("forEach", callbackFn, thisArg)
+}
+
+// Get wraps the map get instance method.
+//
+// https://developer.mozilla.org/en-US/docs/Web/JavaScript/Reference/Global_Objects/Map/get
+func (m MapValue) Get(key any) js.Value {
+	return js.Value(m).Call("get", key)
+}
+
+// Has wraps the map has instance method.
+//
+// https://developer.mozilla.org/en-US/docs/Web/JavaScript/Reference/Global_Objects/Map/has
+func (m MapValue) Has(key any) bool {
+	return js.Value(m).Call("has", key).Bool()
+}
+
+// Keys wraps the map keys instance method.
+//
+// https://developer.mozilla.org/en-US/docs/Web/JavaScript/Reference/Global_Objects/Map/keys
+func (m MapValue) Keys() js.Value {
+	return js.Value(m).Call("keys")
+}
+
+// Set wraps the map set instance method.
+//
+// https://developer.mozilla.org/en-US/docs/Web/JavaScript/Reference/Global_Objects/Map/set
+func (m MapValue) Set(key any, value any) MapValue {
+	res := js.Value(m).Call("set", key, value)
+	return MapValue(res)
+}
+
+// Values wraps the map values instance method.
+//
+// https://developer.mozilla.org/en-US/docs/Web/JavaScript/Reference/Global_Objects/Map/values
+func (m MapValue) Values() js.Value {
+	return js.Value(m).Call("values")
+}
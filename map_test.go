@@ -0,0 +1,33 @@
+//go:build js
+
+package goji
+
+import (
+	"syscall/js"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewMap(t *testing.T) {
+	m1 := Map.New()
+	assert.Equal(t, 0, m1.Size())
+
+	m2 := Map.New(js.Value(Array.Of(js.Value(Array.Of("one", 1)), js.Value(Array.Of("two", 2)))))
+	assert.Equal(t, 2, m2.Size())
+	assert.Equal(t, 2, m2.Get("two").Int())
+}
+
+func TestMapSetAndDelete(t *testing.T) {
+	m := Map.New().Set("one", 1).Set(2, "two")
+	assert.True(t, m.Has("one"))
+	assert.True(t, m.Has(2))
+	assert.False(t, m.Has("2"))
+
+	assert.True(t, m.Delete("one"))
+	assert.False(t, m.Delete("one"))
+	assert.Equal(t, 1, m.Size())
+
+	m.Clear()
+	assert.Equal(t, 0, m.Size())
+}
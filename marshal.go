@@ -0,0 +1,408 @@
+//go:build js
+
+package goji
+
+import (
+	"fmt"
+	"math/big"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"syscall/js"
+	"time"
+)
+
+func init() {
+	jsObject = js.Global().Get("Object")
+	jsBigInt = js.Global().Get("BigInt")
+	jsString = js.Global().Get("String")
+	jsHasOwnProperty = jsObject.Get("prototype").Get("hasOwnProperty")
+}
+
+var (
+	jsObject         js.Value
+	jsBigInt         js.Value
+	jsString         js.Value
+	jsHasOwnProperty js.Value
+)
+
+var (
+	jsValueType       = reflect.TypeOf(js.Value{})
+	jsFuncType        = reflect.TypeOf(js.Func{})
+	timeType          = reflect.TypeOf(time.Time{})
+	bigIntType        = reflect.TypeOf(big.Int{})
+	marshalerJSType   = reflect.TypeOf((*MarshalerJS)(nil)).Elem()
+	unmarshalerJSType = reflect.TypeOf((*UnmarshalerJS)(nil)).Elem()
+)
+
+// MarshalerJS is implemented by types that can marshal themselves into a js.Value.
+type MarshalerJS interface {
+	MarshalJS() (js.Value, error)
+}
+
+// UnsupportedTypeError is returned by MarshalJS when
+// attempting to marshal an unsupported value type.
+type UnsupportedTypeError struct {
+	Type reflect.Type
+}
+
+func (e *UnsupportedTypeError) Error() string {
+	return "goji: unsupported type: " + e.Type.String()
+}
+
+// UnsupportedValueError is returned by MarshalJS when
+// attempting to marshal an unsupported value such as a cyclic value.
+type UnsupportedValueError struct {
+	Value reflect.Value
+	Str   string
+}
+
+func (e *UnsupportedValueError) Error() string {
+	return "goji: unsupported value: " + e.Str
+}
+
+// MarshalJS marshals the given value into a js.Value.
+//
+// Struct fields are encoded as object properties named by the field's
+// `js` tag, falling back to the `json` tag and then the field name.
+// The tag options "omitempty" and "-" behave as they do in encoding/json.
+//
+// Values are encoded as follows:
+//
+//   - js.Value, js.Func and types convertible to js.Value as themselves
+//   - MarshalerJS implementations by calling MarshalJS
+//   - bool, string and numbers as their JS primitives
+//   - int64, uint64 and *big.Int as BigInt
+//   - []byte as Uint8Array
+//   - time.Time as Date
+//   - maps with string keys as Object and all other maps as Map
+//   - structs as Object
+//   - slices and arrays as Array
+//   - nil pointers, interfaces, maps and slices as null
+func MarshalJS(v any) (js.Value, error) {
+	e := encoder{visited: make(map[any]struct{})}
+	return e.encode(reflect.ValueOf(v))
+}
+
+// MustMarshalJS marshals the given value into a js.Value or panics.
+func MustMarshalJS(v any) js.Value {
+	value, err := MarshalJS(v)
+	if err != nil {
+		panic(err)
+	}
+	return value
+}
+
+// visitKey identifies a pointer, map or slice that is being encoded.
+//
+// The length is part of the key so that a slice and a shorter
+// slice sharing the same backing array are not reported as a cycle.
+type visitKey struct {
+	ptr any
+	len int
+	typ reflect.Type
+}
+
+// encoder contains the state used to encode a value.
+type encoder struct {
+	// visited contains the pointers, maps and slices that are being encoded
+	// and is used to detect cyclic values.
+	visited map[any]struct{}
+}
+
+func (e *encoder) encode(v reflect.Value) (js.Value, error) {
+	if !v.IsValid() {
+		return js.Null(), nil
+	}
+	t := v.Type()
+	if t.Implements(marshalerJSType) {
+		if (t.Kind() == reflect.Pointer || t.Kind() == reflect.Interface) && v.IsNil() {
+			return js.Null(), nil
+		}
+		return v.Interface().(MarshalerJS).MarshalJS()
+	}
+	if t.Kind() != reflect.Pointer && v.CanAddr() && reflect.PointerTo(t).Implements(marshalerJSType) {
+		return v.Addr().Interface().(MarshalerJS).MarshalJS()
+	}
+
+	switch t {
+	case jsValueType:
+		return v.Interface().(js.Value), nil
+	case jsFuncType:
+		return v.Interface().(js.Func).Value, nil
+	case timeType:
+		return js.Value(DateFromTime(v.Interface().(time.Time))), nil
+	case bigIntType:
+		n := v.Interface().(big.Int)
+		return jsBigInt.Invoke(n.String()), nil
+	}
+	if t.Kind() == reflect.Struct && t.ConvertibleTo(jsValueType) {
+		return v.Convert(jsValueType).Interface().(js.Value), nil
+	}
+
+	switch t.Kind() {
+	case reflect.Bool:
+		return js.ValueOf(v.Bool()), nil
+
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32:
+		return js.ValueOf(v.Int()), nil
+
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uintptr:
+		return js.ValueOf(v.Uint()), nil
+
+	case reflect.Int64:
+		return jsBigInt.Invoke(strconv.FormatInt(v.Int(), 10)), nil
+
+	case reflect.Uint64:
+		return jsBigInt.Invoke(strconv.FormatUint(v.Uint(), 10)), nil
+
+	case reflect.Float32, reflect.Float64:
+		return js.ValueOf(v.Float()), nil
+
+	case reflect.String:
+		return js.ValueOf(v.String()), nil
+
+	case reflect.Interface:
+		if v.IsNil() {
+			return js.Null(), nil
+		}
+		return e.encode(v.Elem())
+
+	case reflect.Pointer:
+		if v.IsNil() {
+			return js.Null(), nil
+		}
+		key := visitKey{ptr: v.Pointer(), typ: t}
+		if _, ok := e.visited[key]; ok {
+			return js.Undefined(), &UnsupportedValueError{v, fmt.Sprintf("encountered a cycle via %s", t)}
+		}
+		e.visited[key] = struct{}{}
+		defer delete(e.visited, key)
+		return e.encode(v.Elem())
+
+	case reflect.Slice:
+		if v.IsNil() {
+			return js.Null(), nil
+		}
+		if t.Elem().Kind() == reflect.Uint8 && !reflect.PointerTo(t.Elem()).Implements(marshalerJSType) {
+			return js.Value(Uint8ArrayFromBytes(v.Bytes())), nil
+		}
+		key := visitKey{ptr: v.Pointer(), len: v.Len(), typ: t}
+		if _, ok := e.visited[key]; ok {
+			return js.Undefined(), &UnsupportedValueError{v, fmt.Sprintf("encountered a cycle via %s", t)}
+		}
+		e.visited[key] = struct{}{}
+		defer delete(e.visited, key)
+		return e.encodeArray(v)
+
+	case reflect.Array:
+		return e.encodeArray(v)
+
+	case reflect.Map:
+		if v.IsNil() {
+			return js.Null(), nil
+		}
+		key := visitKey{ptr: v.Pointer(), typ: t}
+		if _, ok := e.visited[key]; ok {
+			return js.Undefined(), &UnsupportedValueError{v, fmt.Sprintf("encountered a cycle via %s", t)}
+		}
+		e.visited[key] = struct{}{}
+		defer delete(e.visited, key)
+
+		if t.Key().Kind() == reflect.String {
+			return e.encodeObject(v)
+		}
+		return e.encodeMap(v)
+
+	case reflect.Struct:
+		return e.encodeStruct(v)
+
+	default:
+		return js.Undefined(), &UnsupportedTypeError{t}
+	}
+}
+
+func (e *encoder) encodeArray(v reflect.Value) (js.Value, error) {
+	res := js.Value(Array.New(v.Len()))
+	for i := 0; i < v.Len(); i++ {
+		elem, err := e.encode(v.Index(i))
+		if err != nil {
+			return js.Undefined(), err
+		}
+		res.SetIndex(i, elem)
+	}
+	return res, nil
+}
+
+func (e *encoder) encodeObject(v reflect.Value) (js.Value, error) {
+	keys := v.MapKeys()
+	sort.Slice(keys, func(i, j int) bool {
+		return keys[i].String() < keys[j].String()
+	})
+	res := jsObject.New()
+	for _, k := range keys {
+		elem, err := e.encode(v.MapIndex(k))
+		if err != nil {
+			return js.Undefined(), err
+		}
+		res.Set(k.String(), elem)
+	}
+	return res, nil
+}
+
+func (e *encoder) encodeMap(v reflect.Value) (js.Value, error) {
+	res := Map.New()
+	iter := v.MapRange()
+	for iter.Next() {
+		key, err := e.encode(iter.Key())
+		if err != nil {
+			return js.Undefined(), err
+		}
+		elem, err := e.encode(iter.Value())
+		if err != nil {
+			return js.Undefined(), err
+		}
+		res.Set(key, elem)
+	}
+	return js.Value(res), nil
+}
+
+func (e *encoder) encodeStruct(v reflect.Value) (js.Value, error) {
+	res := jsObject.New()
+	for _, f := range cachedFields(v.Type()) {
+		fv, ok := fieldByIndex(v, f.index, false)
+		if !ok {
+			continue
+		}
+		if f.omitEmpty && isEmptyValue(fv) {
+			continue
+		}
+		elem, err := e.encode(fv)
+		if err != nil {
+			return js.Undefined(), err
+		}
+		res.Set(f.name, elem)
+	}
+	return res, nil
+}
+
+// field describes a struct field that is encoded as an object property.
+type field struct {
+	name      string
+	index     []int
+	omitEmpty bool
+}
+
+// fieldCache contains the cached fields for each struct type.
+var fieldCache sync.Map
+
+// cachedFields returns the encoded fields of the given struct type.
+func cachedFields(t reflect.Type) []field {
+	if f, ok := fieldCache.Load(t); ok {
+		return f.([]field)
+	}
+	f, _ := fieldCache.LoadOrStore(t, typeFields(t, nil))
+	return f.([]field)
+}
+
+// typeFields returns the encoded fields of the given struct type.
+//
+// Fields of embedded structs without a name tag are promoted
+// unless a field with the same name exists in the outer struct.
+func typeFields(t reflect.Type, index []int) []field {
+	var fields []field
+	var embedded []field
+	names := make(map[string]struct{})
+
+	for i := 0; i < t.NumField(); i++ {
+		sf := t.Field(i)
+		name, opts, skip := fieldTag(sf)
+		if skip {
+			continue
+		}
+		ft := sf.Type
+		if ft.Kind() == reflect.Pointer {
+			ft = ft.Elem()
+		}
+		fieldIndex := append(append([]int{}, index...), i)
+		if sf.Anonymous && name == "" && ft.Kind() == reflect.Struct && !ft.ConvertibleTo(jsValueType) {
+			embedded = append(embedded, typeFields(ft, fieldIndex)...)
+			continue
+		}
+		if !sf.IsExported() {
+			continue
+		}
+		if name == "" {
+			name = sf.Name
+		}
+		names[name] = struct{}{}
+		fields = append(fields, field{
+			name:      name,
+			index:     fieldIndex,
+			omitEmpty: strings.Contains(opts, ",omitempty"),
+		})
+	}
+	for _, f := range embedded {
+		if _, ok := names[f.name]; ok {
+			continue
+		}
+		names[f.name] = struct{}{}
+		fields = append(fields, f)
+	}
+	return fields
+}
+
+// fieldTag returns the name and options from the js tag of the given
+// field, falling back to the json tag when no js tag is present.
+func fieldTag(sf reflect.StructField) (name string, opts string, skip bool) {
+	tag, ok := sf.Tag.Lookup("js")
+	if !ok {
+		tag = sf.Tag.Get("json")
+	}
+	if tag == "-" {
+		return "", "", true
+	}
+	name, rest, _ := strings.Cut(tag, ",")
+	if rest != "" {
+		opts = "," + rest
+	}
+	return name, opts, false
+}
+
+// fieldByIndex returns the nested field of v with the given index.
+//
+// If alloc is true nil embedded pointers are allocated, otherwise
+// false is returned when a nil embedded pointer is encountered.
+func fieldByIndex(v reflect.Value, index []int, alloc bool) (reflect.Value, bool) {
+	for i, x := range index {
+		if i > 0 && v.Kind() == reflect.Pointer {
+			if v.IsNil() {
+				if !alloc {
+					return reflect.Value{}, false
+				}
+				v.Set(reflect.New(v.Type().Elem()))
+			}
+			v = v.Elem()
+		}
+		v = v.Field(x)
+	}
+	return v, true
+}
+
+// isEmptyValue returns true if the value is empty
+// using the same rules as encoding/json.
+func isEmptyValue(v reflect.Value) bool {
+	switch v.Kind() {
+	case reflect.Array, reflect.Map, reflect.Slice, reflect.String:
+		return v.Len() == 0
+	case reflect.Bool,
+		reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr,
+		reflect.Float32, reflect.Float64,
+		reflect.Interface, reflect.Pointer:
+		return v.IsZero()
+	}
+	return false
+}
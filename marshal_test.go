@@ -0,0 +1,211 @@
+//go:build js
+
+package goji
+
+import (
+	"encoding/json"
+	"math/big"
+	"syscall/js"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// taggedType is a type used to test struct tags
+type taggedType struct {
+	Name     string `js:"name"`
+	Nickname string `js:"nickname,omitempty"`
+	Secret   string `js:"-"`
+	Legacy   string `json:"legacy"`
+	hidden   string
+}
+
+// embeddedType is a type used to test embedded structs
+type embeddedType struct {
+	taggedType
+	Count int `js:"count"`
+}
+
+// benchType is a type used to benchmark marshalling
+type benchType struct {
+	Name   string            `json:"name"`
+	Count  int               `json:"count"`
+	Scores []float64         `json:"scores"`
+	Labels map[string]string `json:"labels"`
+	Nested []benchType       `json:"nested,omitempty"`
+}
+
+// marshalJSON is the JSON round trip previously used by MarshalJS.
+func marshalJSON(v any) (js.Value, error) {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return js.Undefined(), err
+	}
+	return JSON.Parse(string(data)), nil
+}
+
+// newBenchValue returns a value used to benchmark marshalling.
+func newBenchValue() benchType {
+	value := benchType{
+		Name:   "root",
+		Count:  1,
+		Scores: []float64{1.5, 2.5, 3.5},
+		Labels: map[string]string{"a": "1", "b": "2"},
+	}
+	for i := 0; i < 100; i++ {
+		value.Nested = append(value.Nested, benchType{
+			Name:   "child",
+			Count:  i,
+			Scores: []float64{float64(i)},
+			Labels: map[string]string{"index": "child"},
+		})
+	}
+	return value
+}
+
+func TestMarshalJSStructTags(t *testing.T) {
+	value, err := MarshalJS(taggedType{
+		Name:   "Alice",
+		Secret: "secret",
+		Legacy: "legacy",
+		hidden: "hidden",
+	})
+	require.NoError(t, err)
+
+	assert.Equal(t, "Alice", value.Get("name").String())
+	assert.Equal(t, "legacy", value.Get("legacy").String())
+	assert.True(t, value.Get("nickname").IsUndefined())
+	assert.True(t, value.Get("Secret").IsUndefined())
+	assert.True(t, value.Get("hidden").IsUndefined())
+}
+
+func TestMarshalJSEmbeddedStruct(t *testing.T) {
+	value, err := MarshalJS(embeddedType{
+		taggedType: taggedType{Name: "Alice"},
+		Count:      2,
+	})
+	require.NoError(t, err)
+
+	assert.Equal(t, "Alice", value.Get("name").String())
+	assert.Equal(t, 2, value.Get("count").Int())
+}
+
+func TestMarshalJSBytes(t *testing.T) {
+	value, err := MarshalJS([]byte{1, 2, 3})
+	require.NoError(t, err)
+
+	require.True(t, value.InstanceOf(js.Value(Uint8Array)))
+	assert.Equal(t, []byte{1, 2, 3}, BytesFromUint8Array(Uint8ArrayValue(value)))
+}
+
+func TestMarshalJSTime(t *testing.T) {
+	now := time.UnixMilli(time.Now().UnixMilli())
+
+	value, err := MarshalJS(now)
+	require.NoError(t, err)
+
+	require.True(t, value.InstanceOf(js.Value(Date)))
+	assert.Equal(t, float64(now.UnixMilli()), value.Call("getTime").Float())
+}
+
+func TestMarshalJSBigInt(t *testing.T) {
+	n, ok := new(big.Int).SetString("123456789012345678901234567890", 10)
+	require.True(t, ok)
+
+	value, err := MarshalJS(n)
+	require.NoError(t, err)
+	assert.Equal(t, "bigint", js.Global().Call("eval", "(v) => typeof v").Invoke(value).String())
+	assert.Equal(t, n.String(), bigIntString(value))
+
+	value, err = MarshalJS(int64(9007199254740993))
+	require.NoError(t, err)
+	assert.Equal(t, "9007199254740993", bigIntString(value))
+}
+
+func TestMarshalJSMap(t *testing.T) {
+	value, err := MarshalJS(map[string]int{"a": 1})
+	require.NoError(t, err)
+	assert.False(t, value.InstanceOf(js.Value(Map)))
+	assert.Equal(t, 1, value.Get("a").Int())
+
+	value, err = MarshalJS(map[int]string{1: "a"})
+	require.NoError(t, err)
+	require.True(t, value.InstanceOf(js.Value(Map)))
+	assert.Equal(t, "a", value.Call("get", 1).String())
+}
+
+func TestMarshalJSNil(t *testing.T) {
+	var ptr *taggedType
+	var slice []string
+
+	value, err := MarshalJS(ptr)
+	require.NoError(t, err)
+	assert.True(t, value.IsNull())
+
+	value, err = MarshalJS(slice)
+	require.NoError(t, err)
+	assert.True(t, value.IsNull())
+}
+
+func TestMarshalJSValue(t *testing.T) {
+	expect := js.Global().Get("Object").New()
+
+	value, err := MarshalJS(map[string]any{"value": expect})
+	require.NoError(t, err)
+	assert.True(t, value.Get("value").Equal(expect))
+}
+
+func TestMarshalJSMarshaler(t *testing.T) {
+	value, err := MarshalJS(marshalerType{Value: "custom"})
+	require.NoError(t, err)
+	assert.Equal(t, "custom", value.String())
+}
+
+func TestMarshalJSCycle(t *testing.T) {
+	type node struct {
+		Next *node
+	}
+	n := &node{}
+	n.Next = n
+
+	_, err := MarshalJS(n)
+	var valueErr *UnsupportedValueError
+	require.ErrorAs(t, err, &valueErr)
+}
+
+func TestMarshalJSSliceCycle(t *testing.T) {
+	s := make([]any, 1)
+	s[0] = s
+
+	_, err := MarshalJS(s)
+	var valueErr *UnsupportedValueError
+	require.ErrorAs(t, err, &valueErr)
+}
+
+func TestMarshalJSUnsupportedType(t *testing.T) {
+	_, err := MarshalJS(make(chan int))
+	var typeErr *UnsupportedTypeError
+	require.ErrorAs(t, err, &typeErr)
+}
+
+func BenchmarkMarshalJS(b *testing.B) {
+	value := newBenchValue()
+	for i := 0; i < b.N; i++ {
+		_, err := MarshalJS(value)
+		if err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func BenchmarkMarshalJSON(b *testing.B) {
+	value := newBenchValue()
+	for i := 0; i < b.N; i++ {
+		_, err := marshalJSON(value)
+		if err != nil {
+			b.Fatal(err)
+		}
+	}
+}
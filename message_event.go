@@ -0,0 +1,137 @@
+// Code generated by gojigen from message_event.webidl. DO NOT EDIT.
+
+//go:build js
+
+package goji
+
+import "syscall/js"
+
+func init() {
+	MessageEvent = messageEventJS(js.Global().Get("MessageEvent"))
+}
+
+type messageEventJS js.Value
+
+// MessageEvent is a wrapper for the MessageEvent global interface.
+//
+// https://developer.mozilla.org/en-US/docs/Web/API/MessageEvent
+var MessageEvent messageEventJS
+
+// New wraps the MessageEvent constructor.
+//
+// https://developer.mozilla.org/en-US/docs/Web/API/MessageEvent/MessageEvent
+func (e messageEventJS) New(eventType string, opts ...eventOption) MessageEventValue {
+	switch {
+	case len(opts) > 0:
+		options := js.ValueOf(map[string]any{})
+		for _, opt := range opts {
+			opt(options)
+		}
+		res := js.Value(e).New(eventType, options)
+		return MessageEventValue(res)
+
+	default:
+		res := js.Value(e).New(eventType)
+		return MessageEventValue(res)
+	}
+}
+
+// MessageEventValue is an instance of MessageEvent.
+type MessageEventValue js.Value
+
+// Data returns the MessageEvent data property.
+//
+// https://developer.mozilla.org/en-US/docs/Web/API/MessageEvent/data
+func (e MessageEventValue) Data() js.Value {
+	return js.Value(e).Get("data")
+}
+
+// LastEventID returns the MessageEvent lastEventId property.
+//
+// https://developer.mozilla.org/en-US/docs/Web/API/MessageEvent/lastEventId
+func (e MessageEventValue) LastEventID() string {
+	return js.Value(e).Get("lastEventId").String()
+}
+
+// Origin returns the MessageEvent origin property.
+//
+// https://developer.mozilla.org/en-US/docs/Web/API/MessageEvent/origin
+func (e MessageEventValue) Origin() string {
+	return js.Value(e).Get("origin").String()
+}
+
+// Ports returns the MessageEvent ports property.
+//
+// https://developer.mozilla.org/en-US/docs/Web/API/MessageEvent/ports
+func (e MessageEventValue) Ports() ArrayValue {
+	res := js.Value(e).Get("ports")
+	return ArrayValue(res)
+}
+
+// Source returns the MessageEvent source property.
+//
+// https://developer.mozilla.org/en-US/docs/Web/API/MessageEvent/source
+//
+// The source is null if the message was not sent by a window,
+// worker or port.
+func (e MessageEventValue) Source() Nullable[js.Value] {
+	res := js.Value(e).Get("source")
+	return NullableAs[js.Value](res)
+}
+
+// Event returns the parent Event.
+func (e MessageEventValue) Event() EventValue {
+	return EventValue(e)
+}
+
+// MessageEventOptions is used to set MessageEvent options.
+//
+// The options in EventOptions can also be used with MessageEvent.
+var MessageEventOptions = &messageEventOptions{}
+
+type messageEventOptions struct{}
+
+// WithData sets the data option.
+//
+// https://developer.mozilla.org/en-US/docs/Web/API/MessageEvent/MessageEvent#data
+func (e messageEventOptions) WithData(data js.Value) eventOption {
+	return func(value js.Value) {
+		value.Set("data", data)
+	}
+}
+
+// WithLastEventID sets the lastEventId option.
+//
+// https://developer.mozilla.org/en-US/docs/Web/API/MessageEvent/MessageEvent#lasteventid
+func (e messageEventOptions) WithLastEventID(lastEventID string) eventOption {
+	return func(value js.Value) {
+		value.Set("lastEventId", lastEventID)
+	}
+}
+
+// WithOrigin sets the origin option.
+//
+// https://developer.mozilla.org/en-US/docs/Web/API/MessageEvent/MessageEvent#origin
+func (e messageEventOptions) WithOrigin(origin string) eventOption {
+	return func(value js.Value) {
+		value.Set("origin", origin)
+	}
+}
+
+// WithPorts sets the ports option.
+//
+// https://developer.mozilla.org/en-US/docs/Web/API/MessageEvent/MessageEvent#ports
+func (e messageEventOptions) WithPorts(ports js.Value) eventOption {
+	return func(value js.Value) {
+		value.Set("ports", ports)
+	}
+}
+
+// WithSource sets the source option.
+//
+// https://developer.mozilla.org/en-US/docs/Web/API/MessageEvent/MessageEvent#source
+func (e messageEventOptions) WithSource(source js.Value) eventOption {
+	return func(value js.Value) {
+		value.Set("source", source)
+	}
+}
@@ -0,0 +1,29 @@
+//go:build js
+
+package goji
+
+import (
+	"syscall/js"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMessageEventNew(t *testing.T) {
+	if js.Global().Get("MessageEvent").IsUndefined() {
+		t.Skip("MessageEvent is not supported")
+	}
+
+	data := js.ValueOf(map[string]any{"value": 1})
+	event := MessageEvent.New("message",
+		MessageEventOptions.WithData(data),
+		MessageEventOptions.WithOrigin("https://example.com"),
+		MessageEventOptions.WithLastEventID("1"),
+	)
+	assert.Equal(t, "message", event.Event().Type())
+	assert.True(t, event.Data().Equal(data))
+	assert.Equal(t, "https://example.com", event.Origin())
+	assert.Equal(t, "1", event.LastEventID())
+	assert.False(t, event.Source().Valid)
+	assert.Equal(t, 0, event.Ports().Length())
+}
@@ -0,0 +1,113 @@
+//go:build js
+
+package goji
+
+import (
+	"reflect"
+	"syscall/js"
+)
+
+// Nullable is a value of type T that may be null or undefined.
+//
+// It is returned by the wrappers of properties with a nullable type,
+// so reading a property that is not set never panics. The zero value
+// is null.
+type Nullable[T any] struct {
+	// Value is the value if it is not null.
+	Value T
+	// Valid is true if the value is not null.
+	Valid bool
+}
+
+// NullableOf returns a Nullable containing the given value.
+func NullableOf[T any](value T) Nullable[T] {
+	return Nullable[T]{Value: value, Valid: true}
+}
+
+// NullableFrom returns a Nullable containing the given value converted
+// with the given func, or null if the value is null or undefined.
+func NullableFrom[T any](value js.Value, convert func(js.Value) T) Nullable[T] {
+	if value.IsNull() || value.IsUndefined() {
+		return Nullable[T]{}
+	}
+	return NullableOf(convert(value))
+}
+
+// NullableAs returns a Nullable containing the given value converted to T,
+// or null if the value is null or undefined.
+//
+// T must be js.Value or one of the wrapper types with
+// js.Value as the underlying type, such as EventTargetValue.
+func NullableAs[T any](value js.Value) Nullable[T] {
+	return NullableFrom(value, func(value js.Value) T {
+		return reflect.ValueOf(value).Convert(reflect.TypeFor[T]()).Interface().(T)
+	})
+}
+
+// NullableBool returns the given value as a nullable bool.
+func NullableBool(value js.Value) Nullable[bool] {
+	return NullableFrom(value, js.Value.Bool)
+}
+
+// NullableInt returns the given value as a nullable int.
+func NullableInt(value js.Value) Nullable[int] {
+	return NullableFrom(value, js.Value.Int)
+}
+
+// NullableFloat returns the given value as a nullable float64.
+func NullableFloat(value js.Value) Nullable[float64] {
+	return NullableFrom(value, js.Value.Float)
+}
+
+// NullableString returns the given value as a nullable string.
+func NullableString(value js.Value) Nullable[string] {
+	return NullableFrom(value, js.Value.String)
+}
+
+// Get returns the value and true, or the zero value
+// and false if the value is null.
+func (n Nullable[T]) Get() (T, bool) {
+	return n.Value, n.Valid
+}
+
+// Or returns the value, or the given fallback if the value is null.
+func (n Nullable[T]) Or(fallback T) T {
+	if !n.Valid {
+		return fallback
+	}
+	return n.Value
+}
+
+// Ptr returns a pointer to a copy of the value, or nil if the value is null.
+func (n Nullable[T]) Ptr() *T {
+	if !n.Valid {
+		return nil
+	}
+	value := n.Value
+	return &value
+}
+
+// MarshalJS implements MarshalerJS by marshalling
+// the value with MarshalJS, or returning null.
+func (n Nullable[T]) MarshalJS() (js.Value, error) {
+	if !n.Valid {
+		return js.Null(), nil
+	}
+	return MarshalJS(n.Value)
+}
+
+// UnmarshalJS implements UnmarshalerJS by unmarshalling the
+// value with UnmarshalJS, or setting it to null if the given
+// value is null or undefined.
+func (n *Nullable[T]) UnmarshalJS(value js.Value) error {
+	if value.IsNull() || value.IsUndefined() {
+		*n = Nullable[T]{}
+		return nil
+	}
+	var res T
+	if err := UnmarshalJS(value, &res); err != nil {
+		return err
+	}
+	*n = NullableOf(res)
+	return nil
+}
@@ -0,0 +1,64 @@
+//go:build js
+
+package goji
+
+import (
+	"syscall/js"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNullableFromNull(t *testing.T) {
+	for _, value := range []js.Value{js.Null(), js.Undefined()} {
+		res := NullableInt(value)
+		assert.False(t, res.Valid)
+		assert.Nil(t, res.Ptr())
+		assert.Equal(t, 10, res.Or(10))
+	}
+}
+
+func TestNullableFromValue(t *testing.T) {
+	res := NullableString(js.ValueOf("test"))
+	value, ok := res.Get()
+	assert.True(t, ok)
+	assert.Equal(t, "test", value)
+	assert.Equal(t, "test", res.Or("other"))
+	assert.Equal(t, "test", *res.Ptr())
+}
+
+func TestNullableAsWrapper(t *testing.T) {
+	event := Event.New("test")
+
+	target, ok := NullableAs[EventValue](js.Value(event)).Get()
+	require.True(t, ok)
+	assert.Equal(t, "test", target.Type())
+
+	_, ok = NullableAs[EventValue](js.Null()).Get()
+	assert.False(t, ok)
+}
+
+func TestNullableMarshalJS(t *testing.T) {
+	value, err := MarshalJS(Nullable[int]{})
+	require.NoError(t, err)
+	assert.True(t, value.IsNull())
+
+	value, err = MarshalJS(NullableOf(10))
+	require.NoError(t, err)
+	assert.Equal(t, 10, value.Int())
+}
+
+func TestNullableUnmarshalJS(t *testing.T) {
+	type options struct {
+		Name  Nullable[string] `js:"name"`
+		Count Nullable[int]    `js:"count"`
+	}
+
+	value := js.ValueOf(map[string]any{"name": "test", "count": nil})
+
+	var res options
+	require.NoError(t, UnmarshalJS(value, &res))
+	assert.Equal(t, NullableOf("test"), res.Name)
+	assert.Equal(t, Nullable[int]{}, res.Count)
+}
@@ -0,0 +1,54 @@
+//go:build js
+
+package goji
+
+import (
+	"fmt"
+	"runtime/debug"
+	"sync/atomic"
+	"syscall/js"
+)
+
+// PanicErrorName is the name of the JS Error used to
+// reject a promise when the Go func running it panics.
+const PanicErrorName = "GoPanic"
+
+// PanicHandler is called with the recovered value and
+// Go stack trace when a func run by goji panics.
+type PanicHandler func(value any, stack []byte)
+
+// panicHandler contains the current PanicHandler.
+var panicHandler atomic.Pointer[PanicHandler]
+
+// SetPanicHandler sets a handler that is called whenever a func run in a
+// promise by Async or PromiseOf, or a func exported with Export, panics.
+// Passing nil removes the handler.
+//
+// The handler is called before the promise is rejected or the exported
+// func throws and can be used to log or report panics from a central place.
+func SetPanicHandler(handler PanicHandler) {
+	if handler == nil {
+		panicHandler.Store(nil)
+	} else {
+		panicHandler.Store(&handler)
+	}
+}
+
+// recoverPanic recovers from a panic and calls reject with a JS Error
+// named GoPanic that exposes the Go stack trace on its stack property.
+//
+// It must be called directly by a deferred func.
+func recoverPanic(reject func(value js.Value)) {
+	value := recover()
+	if value == nil {
+		return
+	}
+	stack := debug.Stack()
+	if handler := panicHandler.Load(); handler != nil {
+		(*handler)(value, stack)
+	}
+	err := Error.New(fmt.Sprint(value))
+	js.Value(err).Set("name", PanicErrorName)
+	js.Value(err).Set("stack", string(stack))
+	reject(js.Value(err))
+}
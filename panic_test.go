@@ -0,0 +1,58 @@
+//go:build js
+
+package goji
+
+import (
+	"syscall/js"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPromiseOfPanic(t *testing.T) {
+	prom := PromiseOf(func(resolve, reject func(value js.Value)) {
+		panic("test panic")
+	})
+
+	_, err := Await(prom)
+	require.Error(t, err)
+
+	value := js.Value(err.(ErrorValue))
+	assert.Equal(t, PanicErrorName, value.Get("name").String())
+	assert.Equal(t, "test panic", value.Get("message").String())
+	assert.Contains(t, value.Get("stack").String(), "TestPromiseOfPanic")
+}
+
+func TestAsyncPanic(t *testing.T) {
+	fn := Async(func(this js.Value, args []js.Value) (js.Value, error) {
+		panic("test panic")
+	})
+	defer fn.Release()
+
+	_, err := Await(PromiseValue(fn.Invoke()))
+	require.Error(t, err)
+	assert.Equal(t, "GoPanic: test panic", err.Error())
+}
+
+func TestSetPanicHandler(t *testing.T) {
+	var (
+		recovered any
+		stack     []byte
+	)
+	SetPanicHandler(func(value any, s []byte) {
+		recovered = value
+		stack = s
+	})
+	defer SetPanicHandler(nil)
+
+	prom := PromiseOf(func(resolve, reject func(value js.Value)) {
+		panic("test panic")
+	})
+
+	_, err := Await(prom)
+	require.Error(t, err)
+
+	assert.Equal(t, "test panic", recovered)
+	assert.Contains(t, string(stack), "TestSetPanicHandler")
+}
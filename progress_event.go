@@ -0,0 +1,100 @@
+// Code generated by gojigen from progress_event.webidl. DO NOT EDIT.
+
+//go:build js
+
+package goji
+
+import "syscall/js"
+
+func init() {
+	ProgressEvent = progressEventJS(js.Global().Get("ProgressEvent"))
+}
+
+type progressEventJS js.Value
+
+// ProgressEvent is a wrapper for the ProgressEvent global interface.
+//
+// https://developer.mozilla.org/en-US/docs/Web/API/ProgressEvent
+var ProgressEvent progressEventJS
+
+// New wraps the ProgressEvent constructor.
+//
+// https://developer.mozilla.org/en-US/docs/Web/API/ProgressEvent/ProgressEvent
+func (e progressEventJS) New(eventType string, opts ...eventOption) ProgressEventValue {
+	switch {
+	case len(opts) > 0:
+		options := js.ValueOf(map[string]any{})
+		for _, opt := range opts {
+			opt(options)
+		}
+		res := js.Value(e).New(eventType, options)
+		return ProgressEventValue(res)
+
+	default:
+		res := js.Value(e).New(eventType)
+		return ProgressEventValue(res)
+	}
+}
+
+// ProgressEventValue is an instance of ProgressEvent.
+type ProgressEventValue js.Value
+
+// LengthComputable returns the ProgressEvent lengthComputable property.
+//
+// https://developer.mozilla.org/en-US/docs/Web/API/ProgressEvent/lengthComputable
+func (e ProgressEventValue) LengthComputable() bool {
+	return js.Value(e).Get("lengthComputable").Bool()
+}
+
+// Loaded returns the ProgressEvent loaded property.
+//
+// https://developer.mozilla.org/en-US/docs/Web/API/ProgressEvent/loaded
+func (e ProgressEventValue) Loaded() int {
+	return js.Value(e).Get("loaded").Int()
+}
+
+// Total returns the ProgressEvent total property.
+//
+// https://developer.mozilla.org/en-US/docs/Web/API/ProgressEvent/total
+func (e ProgressEventValue) Total() int {
+	return js.Value(e).Get("total").Int()
+}
+
+// Event returns the parent Event.
+func (e ProgressEventValue) Event() EventValue {
+	return EventValue(e)
+}
+
+// ProgressEventOptions is used to set ProgressEvent options.
+//
+// The options in EventOptions can also be used with ProgressEvent.
+var ProgressEventOptions = &progressEventOptions{}
+
+type progressEventOptions struct{}
+
+// WithLengthComputable sets the lengthComputable option.
+//
+// https://developer.mozilla.org/en-US/docs/Web/API/ProgressEvent/ProgressEvent#lengthcomputable
+func (e progressEventOptions) WithLengthComputable(enabled bool) eventOption {
+	return func(value js.Value) {
+		value.Set("lengthComputable", enabled)
+	}
+}
+
+// WithLoaded sets the loaded option.
+//
+// https://developer.mozilla.org/en-US/docs/Web/API/ProgressEvent/ProgressEvent#loaded
+func (e progressEventOptions) WithLoaded(loaded int) eventOption {
+	return func(value js.Value) {
+		value.Set("loaded", loaded)
+	}
+}
+
+// WithTotal sets the total option.
+//
+// https://developer.mozilla.org/en-US/docs/Web/API/ProgressEvent/ProgressEvent#total
+func (e progressEventOptions) WithTotal(total int) eventOption {
+	return func(value js.Value) {
+		value.Set("total", total)
+	}
+}
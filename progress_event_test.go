@@ -0,0 +1,26 @@
+//go:build js
+
+package goji
+
+import (
+	"syscall/js"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestProgressEventNew(t *testing.T) {
+	if js.Global().Get("ProgressEvent").IsUndefined() {
+		t.Skip("ProgressEvent is not supported")
+	}
+
+	event := ProgressEvent.New("progress",
+		ProgressEventOptions.WithLengthComputable(true),
+		ProgressEventOptions.WithLoaded(50),
+		ProgressEventOptions.WithTotal(100),
+	)
+	assert.Equal(t, "progress", event.Event().Type())
+	assert.True(t, event.LengthComputable())
+	assert.Equal(t, 50, event.Loaded())
+	assert.Equal(t, 100, event.Total())
+}
@@ -97,15 +97,17 @@ func (v PromiseValue) Catch(onRejected js.Func) PromiseValue {
 //
 // https://developer.mozilla.org/en-US/docs/Web/JavaScript/Reference/Global_Objects/Promise/finally
 func (v PromiseValue) Finally(onFinally js.Value) PromiseValue {
-	res := js.Value(v).Call("catch", onFinally)
+	res := js.Value(v).Call("finally", onFinally)
 	return PromiseValue(res)
 }
 
 // PromiseOf is a helper function that wraps the given func in a promise.
+//
+// If the func panics the promise is rejected with a JS Error named GoPanic.
 func PromiseOf(fn func(resolve, reject func(value js.Value))) PromiseValue {
 	var executor js.Func
-	executor = js.FuncOf(func(this js.Value, args []js.Value) any {
-		executor.Release()
+	executor = FuncOf(func(this js.Value, args []js.Value) any {
+		ReleaseFunc(executor)
 		resolve := func(value js.Value) {
 			args[0].Invoke(value)
 		}
@@ -114,7 +116,10 @@ func PromiseOf(fn func(resolve, reject func(value js.Value))) PromiseValue {
 		}
 		// the function must run in a new go routine
 		// to avoid blocking the render thread in JS
-		go fn(resolve, reject)
+		go func() {
+			defer recoverPanic(reject)
+			fn(resolve, reject)
+		}()
 		return js.Undefined()
 	})
 	return Promise.New(executor)
@@ -129,22 +134,32 @@ type awaitResult struct {
 // AwaitContext is a helper function that waits for a promise to resolve or reject
 // and returns the results and an error value.
 //
-// This helper function supports context cancellation.
+// This helper function supports context cancellation. The callbacks
+// registered on the promise are kept alive until the promise settles,
+// so cancelling the context never leaves JS calling a released func.
 func AwaitContext(ctx context.Context, promise PromiseValue) ([]js.Value, error) {
-	res := make(chan awaitResult)
-	defer close(res)
+	// the channel is buffered so that a promise that settles
+	// after the context is done never blocks the JS event loop
+	res := make(chan awaitResult, 1)
+
+	var onFulfilled, onRejected js.Func
+	settle := func(out awaitResult) {
+		// only one of the callbacks is ever called so it
+		// is safe to release both once the promise settles
+		ReleaseFunc(onFulfilled)
+		ReleaseFunc(onRejected)
+		res <- out
+	}
 
-	onFulfilled := js.FuncOf(func(this js.Value, args []js.Value) any {
-		res <- awaitResult{val: args}
+	onFulfilled = FuncOf(func(this js.Value, args []js.Value) any {
+		settle(awaitResult{val: args})
 		return js.Undefined()
 	})
-	defer onFulfilled.Release()
 
-	onRejected := js.FuncOf(func(this js.Value, args []js.Value) any {
-		res <- awaitResult{err: ErrorValue(args[0])}
+	onRejected = FuncOf(func(this js.Value, args []js.Value) any {
+		settle(awaitResult{err: ErrorFromJS(args[0])})
 		return js.Undefined()
 	})
-	defer onRejected.Release()
 
 	promise.Then(onFulfilled).Catch(onRejected)
 
@@ -162,14 +177,101 @@ func Await(promise PromiseValue) ([]js.Value, error) {
 	return AwaitContext(context.Background(), promise)
 }
 
+// AwaitAll is a helper function that waits for all of the given promises to resolve
+// and returns their results in order, or the error of the first promise that rejects.
+//
+// https://developer.mozilla.org/en-US/docs/Web/JavaScript/Reference/Global_Objects/Promise/all
+func AwaitAll(ctx context.Context, promises ...PromiseValue) ([]js.Value, error) {
+	res, err := AwaitContext(ctx, Promise.All(promiseArray(promises)))
+	if err != nil {
+		return nil, err
+	}
+	values := make([]js.Value, res[0].Length())
+	for i := range values {
+		values[i] = res[0].Index(i)
+	}
+	return values, nil
+}
+
+// SettledResult contains the outcome of a settled promise.
+type SettledResult struct {
+	// Value is the value the promise was fulfilled with.
+	Value js.Value
+	// Err is the reason the promise was rejected with.
+	Err error
+}
+
+// AwaitAllSettled is a helper function that waits for all of the given promises
+// to settle and returns the outcome of each promise in order.
+//
+// The returned error is only set when the context is done before all promises settle.
+//
+// https://developer.mozilla.org/en-US/docs/Web/JavaScript/Reference/Global_Objects/Promise/allSettled
+func AwaitAllSettled(ctx context.Context, promises ...PromiseValue) ([]SettledResult, error) {
+	res, err := AwaitContext(ctx, Promise.AllSettled(promiseArray(promises)))
+	if err != nil {
+		return nil, err
+	}
+	results := make([]SettledResult, res[0].Length())
+	for i := range results {
+		outcome := res[0].Index(i)
+		if outcome.Get("status").String() == "rejected" {
+			results[i].Err = ErrorFromJS(outcome.Get("reason"))
+		} else {
+			results[i].Value = outcome.Get("value")
+		}
+	}
+	return results, nil
+}
+
+// AwaitAny is a helper function that waits for the first of the given promises to resolve
+// and returns its result. If all of the promises reject the returned error
+// is an AggregateErrorValue containing every rejection reason.
+//
+// https://developer.mozilla.org/en-US/docs/Web/JavaScript/Reference/Global_Objects/Promise/any
+func AwaitAny(ctx context.Context, promises ...PromiseValue) (js.Value, error) {
+	res, err := AwaitContext(ctx, Promise.Any(promiseArray(promises)))
+	if err != nil {
+		return js.Undefined(), err
+	}
+	return res[0], nil
+}
+
+// AwaitRace is a helper function that waits for the first of the given promises
+// to settle and returns its result and error value.
+//
+// https://developer.mozilla.org/en-US/docs/Web/JavaScript/Reference/Global_Objects/Promise/race
+func AwaitRace(ctx context.Context, promises ...PromiseValue) (js.Value, error) {
+	res, err := AwaitContext(ctx, Promise.Race(promiseArray(promises)))
+	if err != nil {
+		return js.Undefined(), err
+	}
+	return res[0], nil
+}
+
+// promiseArray returns a JS array containing the given promises.
+func promiseArray(promises []PromiseValue) js.Value {
+	values := make([]any, len(promises))
+	for i, p := range promises {
+		values[i] = js.Value(p)
+	}
+	return js.ValueOf(values)
+}
+
 // Async is a helper function that wraps the given func in a promise that
 // resolves when no error is returned or rejects when an error is returned.
+//
+// Errors are wrapped as with WrapError, except that the name is only set
+// for exported error types, so an error from errors.New or fmt.Errorf
+// rejects with a JS Error whose string is just the message.
+//
+// If the func panics the promise is rejected with a JS Error named GoPanic.
 func Async(fn func(this js.Value, args []js.Value) (js.Value, error)) js.Func {
-	return js.FuncOf(func(this js.Value, args []js.Value) any {
+	return FuncOf(func(this js.Value, args []js.Value) any {
 		prom := PromiseOf(func(resolve, reject func(value js.Value)) {
 			res, err := fn(this, args)
 			if err != nil {
-				reject(js.Value(Error.New(err.Error())))
+				reject(js.Value(wrapError(err, true)))
 			} else {
 				resolve(res)
 			}
@@ -0,0 +1,55 @@
+// Code generated by gojigen from promise_rejection_event.webidl. DO NOT EDIT.
+
+//go:build js
+
+package goji
+
+import "syscall/js"
+
+func init() {
+	PromiseRejectionEvent = promiseRejectionEventJS(js.Global().Get("PromiseRejectionEvent"))
+}
+
+type promiseRejectionEventJS js.Value
+
+// PromiseRejectionEvent is a wrapper for the PromiseRejectionEvent global interface.
+//
+// https://developer.mozilla.org/en-US/docs/Web/API/PromiseRejectionEvent
+var PromiseRejectionEvent promiseRejectionEventJS
+
+// New wraps the PromiseRejectionEvent constructor.
+//
+// https://developer.mozilla.org/en-US/docs/Web/API/PromiseRejectionEvent/PromiseRejectionEvent
+func (e promiseRejectionEventJS) New(eventType string, promise PromiseValue, reason js.Value, opts ...eventOption) PromiseRejectionEventValue {
+	options := js.ValueOf(map[string]any{})
+	for _, opt := range opts {
+		opt(options)
+	}
+	options.Set("promise", js.Value(promise))
+	options.Set("reason", reason)
+	res := js.Value(e).New(eventType, options)
+	return PromiseRejectionEventValue(res)
+}
+
+// PromiseRejectionEventValue is an instance of PromiseRejectionEvent.
+type PromiseRejectionEventValue js.Value
+
+// Promise returns the PromiseRejectionEvent promise property.
+//
+// https://developer.mozilla.org/en-US/docs/Web/API/PromiseRejectionEvent/promise
+func (e PromiseRejectionEventValue) Promise() PromiseValue {
+	res := js.Value(e).Get("promise")
+	return PromiseValue(res)
+}
+
+// Reason returns the PromiseRejectionEvent reason property.
+//
+// https://developer.mozilla.org/en-US/docs/Web/API/PromiseRejectionEvent/reason
+func (e PromiseRejectionEventValue) Reason() js.Value {
+	return js.Value(e).Get("reason")
+}
+
+// Event returns the parent Event.
+func (e PromiseRejectionEventValue) Event() EventValue {
+	return EventValue(e)
+}
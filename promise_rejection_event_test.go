@@ -0,0 +1,25 @@
+//go:build js
+
+package goji
+
+import (
+	"syscall/js"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPromiseRejectionEventNew(t *testing.T) {
+	if js.Global().Get("PromiseRejectionEvent").IsUndefined() {
+		t.Skip("PromiseRejectionEvent is not supported")
+	}
+
+	reason := js.Value(Error.New("rejected"))
+	promise := Promise.Resolve(js.Undefined())
+
+	event := PromiseRejectionEvent.New("unhandledrejection", promise, reason, EventOptions.WithCancelable(true))
+	assert.Equal(t, "unhandledrejection", event.Event().Type())
+	assert.True(t, event.Event().Cancelable())
+	assert.True(t, js.Value(event.Promise()).Equal(js.Value(promise)))
+	assert.True(t, event.Reason().Equal(reason))
+}
@@ -3,6 +3,8 @@
 package goji
 
 import (
+	"context"
+	"errors"
 	"fmt"
 	"syscall/js"
 	"testing"
@@ -51,6 +53,45 @@ func TestPromiseRejectThenAwait(t *testing.T) {
 	assert.Equal(t, value, err)
 }
 
+func TestPromiseFinally(t *testing.T) {
+	var calls int
+	onFinally := FuncOf(func(this js.Value, args []js.Value) any {
+		calls++
+		return js.Undefined()
+	})
+	defer ReleaseFunc(onFinally)
+
+	prom := Promise.Resolve(js.ValueOf(1)).Finally(onFinally.Value)
+
+	res, err := Await(prom)
+	require.NoError(t, err)
+	assert.Equal(t, 1, res[0].Int())
+	assert.Equal(t, 1, calls)
+}
+
+func TestPromiseAwaitContextCancelled(t *testing.T) {
+	settle := make(chan struct{})
+	prom := PromiseOf(func(resolve, reject func(value js.Value)) {
+		<-settle
+		resolve(js.ValueOf(1))
+	})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	_, err := AwaitContext(ctx, prom)
+	require.ErrorIs(t, err, context.Canceled)
+
+	// settling after cancellation must not panic
+	close(settle)
+
+	res, err := Await(prom)
+	require.NoError(t, err)
+
+	require.Len(t, res, 1)
+	assert.Equal(t, js.ValueOf(1), res[0])
+}
+
 func TestPromiseOfThenAwaitResolve(t *testing.T) {
 	value := js.ValueOf(true)
 	prom := PromiseOf(func(resolve, reject func(value js.Value)) {
@@ -99,3 +140,135 @@ func TestPromiseAsyncReject(t *testing.T) {
 
 	assert.Equal(t, "rejected", err.Error())
 }
+
+func TestPromiseAsyncGlobal(t *testing.T) {
+	fn := Async(func(this js.Value, args []js.Value) (js.Value, error) {
+		return js.ValueOf(1), nil
+	})
+	defer ReleaseFunc(fn)
+
+	// the func is a js.Wrapper so it can be passed to syscall/js
+	js.Global().Set("gojiAsyncGlobal", fn)
+	defer js.Global().Delete("gojiAsyncGlobal")
+
+	prom := PromiseValue(js.Global().Call("gojiAsyncGlobal"))
+	res, err := Await(prom)
+	require.NoError(t, err)
+
+	require.Len(t, res, 1)
+	assert.Equal(t, js.ValueOf(1), res[0])
+}
+
+func TestAwaitAs(t *testing.T) {
+	value, err := MarshalJS(customType{Name: "Alice", Age: 42})
+	require.NoError(t, err)
+
+	res, err := AwaitAs[customType](context.Background(), Promise.Resolve(value))
+	require.NoError(t, err)
+	assert.Equal(t, customType{Name: "Alice", Age: 42}, res)
+}
+
+func TestAwaitAsWrapperType(t *testing.T) {
+	value := js.Value(Array.Of("one", "two"))
+
+	res, err := AwaitAs[ArrayValue](context.Background(), Promise.Resolve(value))
+	require.NoError(t, err)
+	assert.Equal(t, 2, res.Length())
+}
+
+func TestAwaitAsUndefined(t *testing.T) {
+	res, err := AwaitAs[customType](context.Background(), Promise.Resolve(js.Undefined()))
+	require.NoError(t, err)
+	assert.Equal(t, customType{}, res)
+}
+
+func TestPromiseFrom(t *testing.T) {
+	prom := PromiseFrom(customType{Name: "Bob", Age: 41})
+
+	res, err := prom.Await(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, customType{Name: "Bob", Age: 41}, res)
+}
+
+func TestPromiseFromReject(t *testing.T) {
+	prom := PromiseFrom(make(chan int))
+
+	_, err := prom.Await(context.Background())
+	require.Error(t, err)
+}
+
+func TestAwaitAll(t *testing.T) {
+	res, err := AwaitAll(context.Background(),
+		Promise.Resolve(js.ValueOf(1)),
+		Promise.Resolve(js.ValueOf(2)),
+	)
+	require.NoError(t, err)
+
+	require.Len(t, res, 2)
+	assert.Equal(t, 1, res[0].Int())
+	assert.Equal(t, 2, res[1].Int())
+}
+
+func TestAwaitAllReject(t *testing.T) {
+	value := Error.New("test reject")
+	_, err := AwaitAll(context.Background(),
+		Promise.Resolve(js.ValueOf(1)),
+		Promise.Reject(js.Value(value)),
+	)
+	assert.Equal(t, value, err)
+}
+
+func TestAwaitAllSettled(t *testing.T) {
+	value := Error.New("test reject")
+	res, err := AwaitAllSettled(context.Background(),
+		Promise.Resolve(js.ValueOf(1)),
+		Promise.Reject(js.Value(value)),
+	)
+	require.NoError(t, err)
+
+	require.Len(t, res, 2)
+	assert.Equal(t, 1, res[0].Value.Int())
+	assert.NoError(t, res[0].Err)
+	assert.Equal(t, value, res[1].Err)
+}
+
+func TestAwaitAny(t *testing.T) {
+	res, err := AwaitAny(context.Background(),
+		Promise.Reject(js.Value(Error.New("test reject"))),
+		Promise.Resolve(js.ValueOf(2)),
+	)
+	require.NoError(t, err)
+	assert.Equal(t, 2, res.Int())
+}
+
+func TestAwaitAnyAggregateError(t *testing.T) {
+	first := Error.New("first")
+	second := Error.New("second")
+	_, err := AwaitAny(context.Background(),
+		Promise.Reject(js.Value(first)),
+		Promise.Reject(js.Value(second)),
+	)
+
+	var aggregate AggregateErrorValue
+	require.True(t, errors.As(err, &aggregate))
+	assert.Equal(t, []error{first, second}, aggregate.Errors())
+	assert.ErrorIs(t, err, first)
+	assert.ErrorIs(t, err, second)
+}
+
+func TestAwaitRace(t *testing.T) {
+	CheckLeaks(t)
+
+	// the executor never settles the promise
+	executor := FuncOf(func(this js.Value, args []js.Value) any {
+		return js.Undefined()
+	})
+	defer ReleaseFunc(executor)
+
+	res, err := AwaitRace(context.Background(),
+		Promise.New(executor),
+		Promise.Resolve(js.ValueOf(2)),
+	)
+	require.NoError(t, err)
+	assert.Equal(t, 2, res.Int())
+}
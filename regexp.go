@@ -0,0 +1,84 @@
+//go:build js
+
+package goji
+
+import "syscall/js"
+
+func init() {
+	RegExp = regExpJS(js.Global().Get("RegExp"))
+}
+
+type regExpJS js.Value
+
+// RegExp is a wrapper for the RegExp global object.
+//
+// https://developer.mozilla.org/en-US/docs/Web/JavaScript/Reference/Global_Objects/RegExp
+var RegExp regExpJS
+
+// RegExpValue is an instance of RegExp.
+type RegExpValue js.Value
+
+// New wraps the regexp constructor.
+//
+// https://developer.mozilla.org/en-US/docs/Web/JavaScript/Reference/Global_Objects/RegExp/RegExp
+func (r regExpJS) New(pattern string, flags string) RegExpValue {
+	res := js.Value(r).New(pattern, flags)
+	return RegExpValue(res)
+}
+
+// Flags wraps the regexp flags property.
+//
+// https://developer.mozilla.org/en-US/docs/Web/JavaScript/Reference/Global_Objects/RegExp/flags
+func (r RegExpValue) Flags() string {
+	return js.Value(r).Get("flags").String()
+}
+
+// Global wraps the regexp global property.
+//
+// https://developer.mozilla.org/en-US/docs/Web/JavaScript/Reference/Global_Objects/RegExp/global
+func (r RegExpValue) Global() bool {
+	return js.Value(r).Get("global").Bool()
+}
+
+// IgnoreCase wraps the regexp ignoreCase property.
+//
+// https://developer.mozilla.org/en-US/docs/Web/JavaScript/Reference/Global_Objects/RegExp/ignoreCase
+func (r RegExpValue) IgnoreCase() bool {
+	return js.Value(r).Get("ignoreCase").Bool()
+}
+
+// LastIndex wraps the regexp lastIndex property.
+//
+// https://developer.mozilla.org/en-US/docs/Web/JavaScript/Reference/Global_Objects/RegExp/lastIndex
+func (r RegExpValue) LastIndex() int {
+	return js.Value(r).Get("lastIndex").Int()
+}
+
+// Multiline wraps the regexp multiline property.
+//
+// https://developer.mozilla.org/en-US/docs/Web/JavaScript/Reference/Global_Objects/RegExp/multiline
+func (r RegExpValue) Multiline() bool {
+	return js.Value(r).Get("multiline").Bool()
+}
+
+// Source wraps the regexp source property.
+//
+// https://developer.mozilla.org/en-US/docs/Web/JavaScript/Reference/Global_Objects/RegExp/source
+func (r RegExpValue) Source() string {
+	return js.Value(r).Get("source").String()
+}
+
+// Exec wraps the regexp exec instance method.
+//
+// https://developer.mozilla.org/en-US/docs/Web/JavaScript/Reference/Global_Objects/RegExp/exec
+func (r RegExpValue) Exec(str string) ArrayValue {
+	res := js.Value(r).Call("exec", str)
+	return ArrayValue(res)
+}
+
+// Test wraps the regexp test instance method.
+//
+// https://developer.mozilla.org/en-US/docs/Web/JavaScript/Reference/Global_Objects/RegExp/test
+func (r RegExpValue) Test(str string) bool {
+	return js.Value(r).Call("test", str).Bool()
+}
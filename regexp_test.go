@@ -0,0 +1,35 @@
+//go:build js
+
+package goji
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewRegExp(t *testing.T) {
+	r := RegExp.New("a+b", "gi")
+	assert.Equal(t, "a+b", r.Source())
+	assert.Equal(t, "gi", r.Flags())
+	assert.True(t, r.Global())
+	assert.True(t, r.IgnoreCase())
+	assert.False(t, r.Multiline())
+}
+
+func TestRegExpTest(t *testing.T) {
+	r := RegExp.New("^a+b$", "")
+	assert.True(t, r.Test("aab"))
+	assert.False(t, r.Test("abc"))
+}
+
+func TestRegExpExec(t *testing.T) {
+	r := RegExp.New("(\\d+)-(\\d+)", "g")
+
+	res := r.Exec("10-20")
+	require.Equal(t, 3, res.Length())
+	assert.Equal(t, "10", res.At(1).String())
+	assert.Equal(t, "20", res.At(2).String())
+	assert.Equal(t, 5, r.LastIndex())
+}
@@ -0,0 +1,122 @@
+//go:build js
+
+package goji
+
+import (
+	"context"
+	"sync"
+	"syscall/js"
+)
+
+// Scope owns a group of js.Func values and releases them together.
+//
+// A Scope is typically created for a component or a request and closed
+// when it is torn down, so that every callback it created is released
+// with a single call to Close.
+//
+// The zero value is not usable. Use NewScope or NewScopeContext instead.
+type Scope struct {
+	mu       sync.Mutex
+	funcs    []js.Func
+	cleanups []func()
+	closed   bool
+	stop     func() bool
+}
+
+// NewScope returns a new scope that is released when Close is called.
+func NewScope() *Scope {
+	return &Scope{}
+}
+
+// NewScopeContext returns a new scope that is released
+// when Close is called or the given context is done.
+func NewScopeContext(ctx context.Context) *Scope {
+	s := &Scope{}
+	s.stop = context.AfterFunc(ctx, s.Close)
+	return s
+}
+
+// Add transfers ownership of the given func to the scope.
+//
+// If the scope is already closed the func is released immediately.
+func (s *Scope) Add(fn js.Func) js.Func {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.closed {
+		ReleaseFunc(fn)
+		return fn
+	}
+	s.funcs = append(s.funcs, fn)
+	return fn
+}
+
+// FuncOf returns a new func that is owned by the scope.
+//
+// See the FuncOf helper function for more details.
+func (s *Scope) FuncOf(fn func(this js.Value, args []js.Value) any) js.Func {
+	return s.Add(FuncOf(fn))
+}
+
+// Async returns a new func that is owned by the scope.
+//
+// See the Async helper function for more details.
+func (s *Scope) Async(fn func(this js.Value, args []js.Value) (js.Value, error)) js.Func {
+	return s.Add(Async(fn))
+}
+
+// EventListener adds a new event listener to the given target that calls
+// the given func when an event is received.
+//
+// The listener is removed from the target and released when the scope is closed.
+func (s *Scope) EventListener(target EventTargetValue, eventType string, fn func(event EventValue), opts ...eventListenerOption) js.Func {
+	sub := target.Subscribe(eventType, fn, opts...)
+	s.onClose(sub.Close)
+	return sub.listener
+}
+
+// AsyncIteratorOf wraps the given channel into an async iterator object
+// whose funcs are owned by the scope.
+//
+// See the AsyncIteratorOf helper function for more details.
+func (s *Scope) AsyncIteratorOf(from <-chan any) js.Value {
+	object := js.Global().Get("Object").New()
+	return asyncIteratorOf(object, from, s.FuncOf)
+}
+
+// Close releases all of the funcs owned by the scope.
+//
+// Event listeners added by the scope are removed before any funcs are released.
+// Calling Close more than once has no effect.
+func (s *Scope) Close() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.closed {
+		return
+	}
+	s.closed = true
+	if s.stop != nil {
+		s.stop()
+	}
+	for i := len(s.cleanups) - 1; i >= 0; i-- {
+		s.cleanups[i]()
+	}
+	for _, fn := range s.funcs {
+		ReleaseFunc(fn)
+	}
+	s.cleanups = nil
+	s.funcs = nil
+}
+
+// onClose registers the given func to be called when the scope is closed.
+func (s *Scope) onClose(fn func()) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.closed {
+		fn()
+		return
+	}
+	s.cleanups = append(s.cleanups, fn)
+}
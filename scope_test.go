@@ -0,0 +1,113 @@
+//go:build js
+
+package goji
+
+import (
+	"context"
+	"syscall/js"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestScopeClose(t *testing.T) {
+	scope := NewScope()
+
+	var calls int
+	fn := scope.FuncOf(func(this js.Value, args []js.Value) any {
+		calls++
+		return js.Undefined()
+	})
+	fn.Invoke()
+	assert.Equal(t, 1, calls)
+
+	// released funcs are no longer called
+	scope.Close()
+	fn.Invoke()
+	assert.Equal(t, 1, calls)
+
+	// closing again has no effect
+	scope.Close()
+}
+
+func TestScopeAddAfterClose(t *testing.T) {
+	scope := NewScope()
+	scope.Close()
+
+	var calls int
+	fn := scope.FuncOf(func(this js.Value, args []js.Value) any {
+		calls++
+		return js.Undefined()
+	})
+	fn.Invoke()
+	assert.Equal(t, 0, calls)
+}
+
+func TestScopeEventListener(t *testing.T) {
+	scope := NewScope()
+	target := EventTarget.New()
+
+	var count int
+	scope.EventListener(target, "test", func(event EventValue) {
+		count++
+	}, EventListenerOptions.WithCapture(true))
+
+	target.DispatchEvent(js.Value(Event.New("test")))
+	assert.Equal(t, 1, count)
+
+	scope.Close()
+	target.DispatchEvent(js.Value(Event.New("test")))
+	assert.Equal(t, 1, count)
+}
+
+func TestScopeAsync(t *testing.T) {
+	scope := NewScope()
+	defer scope.Close()
+
+	fn := scope.Async(func(this js.Value, args []js.Value) (js.Value, error) {
+		return js.ValueOf(args[0].Int() + 1), nil
+	})
+
+	res, err := Await(PromiseValue(fn.Invoke(1)))
+	require.NoError(t, err)
+	assert.Equal(t, 2, res[0].Int())
+}
+
+func TestScopeAsyncIteratorOf(t *testing.T) {
+	scope := NewScope()
+	defer scope.Close()
+
+	input := make(chan any, 1)
+	input <- "value"
+	close(input)
+
+	var values []string
+	for value, err := range ForAwaitOf(context.Background(), scope.AsyncIteratorOf(input)) {
+		require.NoError(t, err)
+		values = append(values, value.String())
+	}
+	assert.Equal(t, []string{"value"}, values)
+}
+
+func TestScopeContext(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	scope := NewScopeContext(ctx)
+
+	var calls int
+	fn := scope.FuncOf(func(this js.Value, args []js.Value) any {
+		calls++
+		return js.Undefined()
+	})
+
+	cancel()
+	assert.Eventually(t, func() bool {
+		scope.mu.Lock()
+		defer scope.mu.Unlock()
+		return scope.closed
+	}, time.Second, time.Millisecond)
+
+	fn.Invoke()
+	assert.Equal(t, 0, calls)
+}
@@ -0,0 +1,144 @@
+//go:build js
+
+package goji
+
+import "syscall/js"
+
+func init() {
+	Set = setJS(js.Global().Get("Set"))
+}
+
+type setJS js.Value
+
+// Set is a wrapper for the Set global object.
+//
+// https://developer.mozilla.org/en-US/docs/Web/JavaScript/Reference/Global_Objects/Set
+var Set setJS
+
+// SetValue is an instance of Set.
+type SetValue js.Value
+
+// New wraps the set constructor.
+//
+// https://developer.mozilla.org/en-US/docs/Web/JavaScript/Reference/Global_Objects/Set/Set
+func (s setJS) New(iterable ...any) SetValue {
+	res := js.Value(s).New(iterable...)
+	return SetValue(res)
+}
+
+// Size wraps the set size property.
+//
+// https://developer.mozilla.org/en-US/docs/Web/JavaScript/Reference/Global_Objects/Set/size
+func (s SetValue) Size() int {
+	return js.Value(s).Get("size").Int()
+}
+
+// Add wraps the set add instance method.
+//
+// https://developer.mozilla.org/en-US/docs/Web/JavaScript/Reference/Global_Objects/Set/add
+func (s SetValue) Add(value any) SetValue {
+	res := js.Value(s).Call("add", value)
+	return SetValue(res)
+}
+
+// Clear wraps the set clear instance method.
+//
+// https://developer.mozilla.org/en-US/docs/Web/JavaScript/Reference/Global_Objects/Set/clear
+func (s SetValue) Clear() {
+	js.Value(s).Call("clear")
+}
+
+// Delete wraps the set delete instance method.
+//
+// https://developer.mozilla.org/en-US/docs/Web/JavaScript/Reference/Global_Objects/Set/delete
+func (s SetValue) Delete(value any) bool {
+	return js.Value(s).Call("delete", value).Bool()
+}
+
+// Difference wraps the set difference instance method.
+//
+// https://developer.mozilla.org/en-US/docs/Web/JavaScript/Reference/Global_Objects/Set/difference
+func (s SetValue) Difference(other js.Value) SetValue {
+	res := js.Value(s).Call("difference", other)
+	return SetValue(res)
+}
+
+// Entries wraps the set entries instance method.
+//
+// https://developer.mozilla.org/en-US/docs/Web/JavaScript/Reference/Global_Objects/Set/entries
+func (s SetValue) Entries() js.Value {
+	return js.Value(s).Call("entries")
+}
+
+// ForEach wraps the set forEach instance method.
+//
+// https://developer.mozilla.org/en-US/docs/Web/JavaScript/Reference/Global_Objects/Set/forEach
+func (s SetValue) ForEach(callbackFn js.Value, thisArg js.Value) {
+	js.Value(s).Call("forEach", callbackFn, thisArg)
+}
+
+// Has wraps the set has instance method.
+//
+// https://developer.mozilla.org/en-US/docs/Web/JavaScript/Reference/Global_Objects/Set/has
+func (s SetValue) Has(value any) bool {
+	return js.Value(s).Call("has", value).Bool()
+}
+
+// Intersection wraps the set intersection instance method.
+//
+// https://developer.mozilla.org/en-US/docs/Web/JavaScript/Reference/Global_Objects/Set/intersection
+func (s SetValue) Intersection(other js.Value) SetValue {
+	res := js.Value(s).Call("intersection", other)
+	return SetValue(res)
+}
+
+// IsDisjointFrom wraps the set isDisjointFrom instance method.
+//
+// https://developer.mozilla.org/en-US/docs/Web/JavaScript/Reference/Global_Objects/Set/isDisjointFrom
+func (s SetValue) IsDisjointFrom(other js.Value) bool {
+	return js.Value(s).Call("isDisjointFrom", other).Bool()
+}
+
+// IsSubsetOf wraps the set isSubsetOf instance method.
+//
+// https://developer.mozilla.org/en-US/docs/Web/JavaScript/Reference/Global_Objects/Set/isSubsetOf
+func (s SetValue) IsSubsetOf(other js.Value) bool {
+	return js.Value(s).Call("isSubsetOf", other).Bool()
+}
+
+// IsSupersetOf wraps the set isSupersetOf instance method.
+//
+// https://developer.mozilla.org/en-US/docs/Web/JavaScript/Reference/Global_Objects/Set/isSupersetOf
+func (s SetValue) IsSupersetOf(other js.Value) bool {
+	return js.Value(s).Call("isSupersetOf", other).Bool()
+}
+
+// Keys wraps the set keys instance method.
+//
+// https://developer.mozilla.org/en-US/docs/Web/JavaScript/Reference/Global_Objects/Set/keys
+func (s SetValue) Keys() js.Value {
+	return js.Value(s).Call("keys")
+}
+
+// SymmetricDifference wraps the set symmetricDifference instance method.
+//
+// https://developer.mozilla.org/en-US/docs/Web/JavaScript/Reference/Global_Objects/Set/symmetricDifference
+func (s SetValue) SymmetricDifference(other js.Value) SetValue {
+	res := js.Value(s).Call("symmetricDifference", other)
+	return SetValue(res)
+}
+
+// Union wraps the set union instance method.
+//
+// https://developer.mozilla.org/en-US/docs/Web/JavaScript/Reference/Global_Objects/Set/union
+func (s SetValue) Union(other js.Value) SetValue {
+	res := js.Value(s).Call("union", other)
+	return SetValue(res)
+}
+
+// Values wraps the set values instance method.
+//
+// https://developer.mozilla.org/en-US/docs/Web/JavaScript/Reference/Global_Objects/Set/values
+func (s SetValue) Values() js.Value {
+	return js.Value(s).Call("values")
+}
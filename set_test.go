@@ -0,0 +1,37 @@
+//go:build js
+
+package goji
+
+import (
+	"syscall/js"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewSet(t *testing.T) {
+	s1 := Set.New()
+	assert.Equal(t, 0, s1.Size())
+
+	s2 := Set.New(js.Value(Array.Of("one", "two", "one")))
+	assert.Equal(t, 2, s2.Size())
+}
+
+func TestSetAddAndDelete(t *testing.T) {
+	s := Set.New().Add("one").Add("two")
+	assert.True(t, s.Has("one"))
+	assert.False(t, s.Has("three"))
+
+	assert.True(t, s.Delete("one"))
+	assert.False(t, s.Delete("one"))
+	assert.Equal(t, 1, s.Size())
+
+	s.Clear()
+	assert.Equal(t, 0, s.Size())
+}
+
+func TestSetValues(t *testing.T) {
+	s := Set.New(js.Value(Array.Of("one", "two")))
+	values := Array.From(s.Values(), js.Undefined(), js.Undefined())
+	assert.Equal(t, "one,two", values.Join(","))
+}
@@ -0,0 +1,76 @@
+//go:build js
+
+package goji
+
+import "syscall/js"
+
+func init() {
+	SharedArrayBuffer = sharedArrayBufferJS(js.Global().Get("SharedArrayBuffer"))
+}
+
+type sharedArrayBufferJS js.Value
+
+// SharedArrayBuffer is a wrapper for the SharedArrayBuffer global object.
+//
+// SharedArrayBuffer is only defined in cross-origin isolated contexts.
+//
+// https://developer.mozilla.org/en-US/docs/Web/JavaScript/Reference/Global_Objects/SharedArrayBuffer
+var SharedArrayBuffer sharedArrayBufferJS
+
+// SharedArrayBufferValue is an instance of SharedArrayBuffer.
+type SharedArrayBufferValue js.Value
+
+// New wraps the shared array buffer constructor.
+//
+// https://developer.mozilla.org/en-US/docs/Web/JavaScript/Reference/Global_Objects/SharedArrayBuffer/SharedArrayBuffer
+func (s sharedArrayBufferJS) New(length int, opts ...arrayBufferOption) SharedArrayBufferValue {
+	switch {
+	case len(opts) > 0:
+		options := js.ValueOf(map[string]any{})
+		for _, opt := range opts {
+			opt(options)
+		}
+		res := js.Value(s).New(length, options)
+		return SharedArrayBufferValue(res)
+
+	default:
+		res := js.Value(s).New(length)
+		return SharedArrayBufferValue(res)
+	}
+}
+
+// ByteLength wraps the shared array buffer byteLength property.
+//
+// https://developer.mozilla.org/en-US/docs/Web/JavaScript/Reference/Global_Objects/SharedArrayBuffer/byteLength
+func (s SharedArrayBufferValue) ByteLength() int {
+	return js.Value(s).Get("byteLength").Int()
+}
+
+// Growable wraps the shared array buffer growable property.
+//
+// https://developer.mozilla.org/en-US/docs/Web/JavaScript/Reference/Global_Objects/SharedArrayBuffer/growable
+func (s SharedArrayBufferValue) Growable() bool {
+	return js.Value(s).Get("growable").Bool()
+}
+
+// MaxByteLength wraps the shared array buffer maxByteLength property.
+//
+// https://developer.mozilla.org/en-US/docs/Web/JavaScript/Reference/Global_Objects/SharedArrayBuffer/maxByteLength
+func (s SharedArrayBufferValue) MaxByteLength() int {
+	return js.Value(s).Get("maxByteLength").Int()
+}
+
+// Grow wraps the shared array buffer grow instance method.
+//
+// https://developer.mozilla.org/en-US/docs/Web/JavaScript/Reference/Global_Objects/SharedArrayBuffer/grow
+func (s SharedArrayBufferValue) Grow(newLength int) {
+	js.Value(s).Call("grow", newLength)
+}
+
+// Slice wraps the shared array buffer slice instance method.
+//
+// https://developer.mozilla.org/en-US/docs/Web/JavaScript/Reference/Global_Objects/SharedArrayBuffer/slice
+func (s SharedArrayBufferValue) Slice(start, end int) SharedArrayBufferValue {
+	res := js.Value(s).Call("slice", start, end)
+	return SharedArrayBufferValue(res)
+}
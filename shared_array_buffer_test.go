@@ -0,0 +1,20 @@
+//go:build js
+
+package goji
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSharedArrayBufferNew(t *testing.T) {
+	buffer := SharedArrayBuffer.New(8, ArrayBufferOptions.WithMaxByteLength(16))
+	assert.Equal(t, 8, buffer.ByteLength())
+	assert.True(t, buffer.Growable())
+	assert.Equal(t, 16, buffer.MaxByteLength())
+
+	buffer.Grow(12)
+	assert.Equal(t, 12, buffer.ByteLength())
+	assert.Equal(t, 4, buffer.Slice(0, 4).ByteLength())
+}
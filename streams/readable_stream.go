@@ -20,7 +20,7 @@ func (v ReadableStreamValue) Locked() bool {
 	return js.Value(v).Get("locked").Bool()
 }
 
-// Locked calls the ReadableStream.cancel method.
+// Cancel calls the ReadableStream.cancel method.
 //
 // https://developer.mozilla.org/en-US/docs/Web/API/ReadableStream/cancel
 func (v ReadableStreamValue) Cancel(reason string) goji.PromiseValue {
@@ -153,7 +153,7 @@ func (v ReadableStreamDefaultReaderValue) Cancel(reason string) goji.PromiseValu
 	return goji.PromiseValue(res)
 }
 
-// Cancel calls the ReadableStreamDefaultReader.read method.
+// Read calls the ReadableStreamDefaultReader.read method.
 //
 // https://developer.mozilla.org/en-US/docs/Web/API/ReadableStreamDefaultReader/read
 func (v ReadableStreamDefaultReaderValue) Read() goji.PromiseValue {
@@ -181,7 +181,7 @@ func (v ReadableStreamBYOBReaderValue) Closed() goji.PromiseValue {
 	return goji.PromiseValue(res)
 }
 
-// Closed calls the ReadableStreamBYOBReader.cancel method.
+// Cancel calls the ReadableStreamBYOBReader.cancel method.
 //
 // https://developer.mozilla.org/en-US/docs/Web/API/ReadableStreamBYOBReader/cancel
 func (v ReadableStreamBYOBReaderValue) Cancel(reason string) goji.PromiseValue {
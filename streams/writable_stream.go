@@ -60,8 +60,11 @@ func (v WritableStreamDefaultWriterValue) Closed() goji.PromiseValue {
 // DesiredSize returns the WritableStreamDefaultWriter.desiredSize property.
 //
 // https://developer.mozilla.org/en-US/docs/Web/API/WritableStreamDefaultWriter/desiredSize
-func (v WritableStreamDefaultWriterValue) DesiredSize() int {
-	return js.Value(v).Get("desiredSize").Int()
+//
+// The desired size is null if the stream is errored or has an abort queued.
+func (v WritableStreamDefaultWriterValue) DesiredSize() goji.Nullable[int] {
+	res := js.Value(v).Get("desiredSize")
+	return goji.NullableInt(res)
 }
 
 // Ready returns the WritableStreamDefaultWriter.ready property.
@@ -95,7 +98,7 @@ func (v WritableStreamDefaultWriterValue) ReleaseLock() {
 	js.Value(v).Call("releaseLock")
 }
 
-// ReleaseLock calls the WritableStreamDefaultWriter.write method.
+// Write calls the WritableStreamDefaultWriter.write method.
 //
 // https://developer.mozilla.org/en-US/docs/Web/API/WritableStreamDefaultWriter/write
 func (v WritableStreamDefaultWriterValue) Write(chunk js.Value) goji.PromiseValue {
@@ -0,0 +1,30 @@
+//go:build js
+
+package goji
+
+import "syscall/js"
+
+func init() {
+	structuredClone = js.Global().Get("structuredClone")
+}
+
+var structuredClone js.Value
+
+// StructuredClone is a wrapper for the global structuredClone method.
+//
+// The given transferable objects are moved into the returned clone.
+//
+// https://developer.mozilla.org/en-US/docs/Web/API/Window/structuredClone
+func StructuredClone(value js.Value, transfer ...js.Value) js.Value {
+	if len(transfer) == 0 {
+		return structuredClone.Invoke(value)
+	}
+	list := make([]any, len(transfer))
+	for i, v := range transfer {
+		list[i] = v
+	}
+	options := js.ValueOf(map[string]any{
+		"transfer": list,
+	})
+	return structuredClone.Invoke(value, options)
+}
@@ -0,0 +1,38 @@
+//go:build js
+
+package goji
+
+import (
+	"syscall/js"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStructuredClone(t *testing.T) {
+	now := time.UnixMilli(time.Now().UnixMilli())
+
+	value := Map.New().
+		Set("date", js.Value(DateFromTime(now))).
+		Set("set", js.Value(Set.New(js.Value(Array.Of(1, 2))))).
+		Set("regexp", js.Value(RegExp.New("a+", "g")))
+
+	res := StructuredClone(js.Value(value))
+	require.True(t, res.InstanceOf(js.Value(Map)))
+	assert.False(t, res.Equal(js.Value(value)))
+
+	clone := MapValue(res)
+	assert.True(t, now.Equal(DateValue(clone.Get("date")).Time()))
+	assert.Equal(t, 2, SetValue(clone.Get("set")).Size())
+	assert.Equal(t, "a+", RegExpValue(clone.Get("regexp")).Source())
+}
+
+func TestStructuredCloneTransfer(t *testing.T) {
+	buffer := js.Value(Uint8ArrayFromBytes([]byte{1, 2, 3})).Get("buffer")
+
+	res := StructuredClone(buffer, buffer)
+	assert.Equal(t, 0, buffer.Get("byteLength").Int())
+	assert.Equal(t, 3, res.Get("byteLength").Int())
+}
@@ -0,0 +1,93 @@
+//go:build js
+
+package goji
+
+import (
+	"context"
+	"sync"
+	"syscall/js"
+)
+
+// Subscription is an event listener added by Subscribe.
+type Subscription struct {
+	target    EventTargetValue
+	eventType string
+	listener  js.Func
+	options   js.Value
+	signal    js.Value
+	abort     js.Func
+	close     sync.Once
+	stop      func() bool
+}
+
+// Subscribe adds a new event listener that calls the given func
+// when an event is received and returns a subscription that can
+// be used to remove the listener.
+//
+// When the once option is set the subscription is closed
+// automatically after the first event is received, and when
+// the signal option is set it is closed once the signal aborts.
+func (e EventTargetValue) Subscribe(eventType string, fn func(event EventValue), opts ...eventListenerOption) *Subscription {
+	options := js.ValueOf(map[string]any{})
+	for _, opt := range opts {
+		opt(options)
+	}
+	sub := &Subscription{
+		target:    e,
+		eventType: eventType,
+		options:   options,
+	}
+	once := options.Get("once").Truthy()
+	sub.listener = EventListener(func(event EventValue) {
+		if once {
+			defer sub.Close()
+		}
+		fn(event)
+	})
+	js.Value(e).Call("addEventListener", eventType, sub.listener, options)
+
+	signal := options.Get("signal")
+	if !signal.Truthy() {
+		return sub
+	}
+	if signal.Get("aborted").Bool() {
+		sub.Close()
+		return sub
+	}
+	// the listener is removed by the signal without
+	// its func being released, so the abort closes it
+	sub.signal = signal
+	sub.abort = EventListener(func(event EventValue) {
+		sub.Close()
+	})
+	signal.Call("addEventListener", "abort", sub.abort, map[string]any{"once": true})
+	return sub
+}
+
+// SubscribeContext adds a new event listener that calls the given func
+// when an event is received and returns a subscription that can
+// be used to remove the listener.
+//
+// The subscription is closed automatically when the given context is done.
+func (e EventTargetValue) SubscribeContext(ctx context.Context, eventType string, fn func(event EventValue), opts ...eventListenerOption) *Subscription {
+	sub := e.Subscribe(eventType, fn, opts...)
+	sub.stop = context.AfterFunc(ctx, sub.Close)
+	return sub
+}
+
+// Close removes the event listener and releases its func.
+//
+// Calling Close more than once has no effect.
+func (s *Subscription) Close() {
+	s.close.Do(func() {
+		if s.stop != nil {
+			s.stop()
+		}
+		s.target.RemoveEventListener(s.eventType, s.listener.Value, s.options)
+		ReleaseFunc(s.listener)
+		if !s.signal.IsUndefined() {
+			s.signal.Call("removeEventListener", "abort", s.abort)
+			ReleaseFunc(s.abort)
+		}
+	})
+}
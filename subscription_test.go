@@ -0,0 +1,108 @@
+//go:build js
+
+package goji
+
+import (
+	"context"
+	"syscall/js"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSubscribe(t *testing.T) {
+	CheckLeaks(t)
+
+	target := EventTarget.New()
+
+	var count int
+	sub := target.Subscribe("test", func(event EventValue) {
+		assert.Equal(t, "test", event.Type())
+		count++
+	}, EventListenerOptions.WithCapture(true))
+
+	target.DispatchEvent(js.Value(Event.New("test")))
+	target.DispatchEvent(js.Value(Event.New("test")))
+	assert.Equal(t, 2, count)
+
+	sub.Close()
+	target.DispatchEvent(js.Value(Event.New("test")))
+	assert.Equal(t, 2, count)
+
+	// closing again has no effect
+	sub.Close()
+}
+
+func TestSubscribeOnce(t *testing.T) {
+	CheckLeaks(t)
+
+	target := EventTarget.New()
+
+	var count int
+	target.Subscribe("test", func(event EventValue) {
+		count++
+	}, EventListenerOptions.WithOnce(true))
+
+	target.DispatchEvent(js.Value(Event.New("test")))
+	target.DispatchEvent(js.Value(Event.New("test")))
+	assert.Equal(t, 1, count)
+}
+
+func TestSubscribeSignal(t *testing.T) {
+	CheckLeaks(t)
+
+	controller := AbortController.New()
+	target := EventTarget.New()
+
+	var count int
+	target.Subscribe("test", func(event EventValue) {
+		count++
+	}, EventListenerOptions.WithSignal(js.Value(controller.Signal())))
+
+	target.DispatchEvent(js.Value(Event.New("test")))
+	assert.Equal(t, 1, count)
+
+	controller.Abort(js.Undefined())
+	target.DispatchEvent(js.Value(Event.New("test")))
+	assert.Equal(t, 1, count)
+}
+
+func TestSubscribeAbortedSignal(t *testing.T) {
+	CheckLeaks(t)
+
+	target := EventTarget.New()
+
+	var count int
+	target.Subscribe("test", func(event EventValue) {
+		count++
+	}, EventListenerOptions.WithSignal(js.Value(AbortSignal.Abort(js.Undefined()))))
+
+	target.DispatchEvent(js.Value(Event.New("test")))
+	assert.Equal(t, 0, count)
+}
+
+func TestSubscribeContext(t *testing.T) {
+	CheckLeaks(t)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	target := EventTarget.New()
+
+	var count int
+	sub := target.SubscribeContext(ctx, "test", func(event EventValue) {
+		count++
+	})
+
+	target.DispatchEvent(js.Value(Event.New("test")))
+	assert.Equal(t, 1, count)
+
+	cancel()
+	assert.Eventually(t, func() bool {
+		before := count
+		target.DispatchEvent(js.Value(Event.New("test")))
+		return count == before
+	}, time.Second, time.Millisecond)
+
+	// closing after the context is done has no effect
+	sub.Close()
+}
@@ -0,0 +1,284 @@
+//go:build js
+
+package goji
+
+import (
+	"reflect"
+	"syscall/js"
+	"unsafe"
+)
+
+func init() {
+	Int8Array = typedArrayJS[int8](js.Global().Get("Int8Array"))
+	Uint8ClampedArray = typedArrayJS[Uint8Clamped](js.Global().Get("Uint8ClampedArray"))
+	Int16Array = typedArrayJS[int16](js.Global().Get("Int16Array"))
+	Uint16Array = typedArrayJS[uint16](js.Global().Get("Uint16Array"))
+	Int32Array = typedArrayJS[int32](js.Global().Get("Int32Array"))
+	Uint32Array = typedArrayJS[uint32](js.Global().Get("Uint32Array"))
+	Float32Array = typedArrayJS[float32](js.Global().Get("Float32Array"))
+	Float64Array = typedArrayJS[float64](js.Global().Get("Float64Array"))
+	BigInt64Array = typedArrayJS[int64](js.Global().Get("BigInt64Array"))
+	BigUint64Array = typedArrayJS[uint64](js.Global().Get("BigUint64Array"))
+}
+
+// TypedArrayElement is the set of Go types that have a typed array counterpart.
+type TypedArrayElement interface {
+	~int8 | ~uint8 | ~int16 | ~uint16 | ~int32 | ~uint32 | ~int64 | ~uint64 | ~float32 | ~float64
+}
+
+// Uint8Clamped is the element type of a Uint8ClampedArray.
+//
+// It is distinct from uint8 so that a Uint8ClampedArrayValue
+// cannot be mistaken for a Uint8ArrayValue.
+type Uint8Clamped uint8
+
+type typedArrayJS[T TypedArrayElement] js.Value
+
+// Int8Array is a wrapper for the Int8Array global object.
+//
+// https://developer.mozilla.org/en-US/docs/Web/JavaScript/Reference/Global_Objects/Int8Array
+var Int8Array typedArrayJS[int8]
+
+// Uint8ClampedArray is a wrapper for the Uint8ClampedArray global object.
+//
+// https://developer.mozilla.org/en-US/docs/Web/JavaScript/Reference/Global_Objects/Uint8ClampedArray
+var Uint8ClampedArray typedArrayJS[Uint8Clamped]
+
+// Uint8ClampedArrayValue is an instance of Uint8ClampedArray.
+type Uint8ClampedArrayValue = TypedArrayValue[Uint8Clamped]
+
+// Int16Array is a wrapper for the Int16Array global object.
+//
+// https://developer.mozilla.org/en-US/docs/Web/JavaScript/Reference/Global_Objects/Int16Array
+var Int16Array typedArrayJS[int16]
+
+// Uint16Array is a wrapper for the Uint16Array global object.
+//
+// https://developer.mozilla.org/en-US/docs/Web/JavaScript/Reference/Global_Objects/Uint16Array
+var Uint16Array typedArrayJS[uint16]
+
+// Int32Array is a wrapper for the Int32Array global object.
+//
+// https://developer.mozilla.org/en-US/docs/Web/JavaScript/Reference/Global_Objects/Int32Array
+var Int32Array typedArrayJS[int32]
+
+// Uint32Array is a wrapper for the Uint32Array global object.
+//
+// https://developer.mozilla.org/en-US/docs/Web/JavaScript/Reference/Global_Objects/Uint32Array
+var Uint32Array typedArrayJS[uint32]
+
+// Float32Array is a wrapper for the Float32Array global object.
+//
+// https://developer.mozilla.org/en-US/docs/Web/JavaScript/Reference/Global_Objects/Float32Array
+var Float32Array typedArrayJS[float32]
+
+// Float64Array is a wrapper for the Float64Array global object.
+//
+// https://developer.mozilla.org/en-US/docs/Web/JavaScript/Reference/Global_Objects/Float64Array
+var Float64Array typedArrayJS[float64]
+
+// BigInt64Array is a wrapper for the BigInt64Array global object.
+//
+// https://developer.mozilla.org/en-US/docs/Web/JavaScript/Reference/Global_Objects/BigInt64Array
+var BigInt64Array typedArrayJS[int64]
+
+// BigUint64Array is a wrapper for the BigUint64Array global object.
+//
+// https://developer.mozilla.org/en-US/docs/Web/JavaScript/Reference/Global_Objects/BigUint64Array
+var BigUint64Array typedArrayJS[uint64]
+
+// New wraps the typed array constructor.
+//
+// The argument can be a length, an array, an iterable or another typed array.
+//
+// https://developer.mozilla.org/en-US/docs/Web/JavaScript/Reference/Global_Objects/TypedArray/TypedArray
+func (a typedArrayJS[T]) New(lengthOrSource any) TypedArrayValue[T] {
+	res := js.Value(a).New(lengthOrSource)
+	return TypedArrayValue[T](res)
+}
+
+// NewFromBuffer wraps the typed array constructor that
+// creates a view of the given buffer.
+//
+// https://developer.mozilla.org/en-US/docs/Web/JavaScript/Reference/Global_Objects/TypedArray/TypedArray
+func (a typedArrayJS[T]) NewFromBuffer(buffer ArrayBufferValue, byteOffset, length int) TypedArrayValue[T] {
+	res := js.Value(a).New(js.Value(buffer), byteOffset, length)
+	return TypedArrayValue[T](res)
+}
+
+// BytesPerElement wraps the typed array BYTES_PER_ELEMENT static property.
+//
+// https://developer.mozilla.org/en-US/docs/Web/JavaScript/Reference/Global_Objects/TypedArray/BYTES_PER_ELEMENT
+func (a typedArrayJS[T]) BytesPerElement() int {
+	return js.Value(a).Get("BYTES_PER_ELEMENT").Int()
+}
+
+// From wraps the typed array from static method.
+//
+// https://developer.mozilla.org/en-US/docs/Web/JavaScript/Reference/Global_Objects/TypedArray/from
+func (a typedArrayJS[T]) From(arrayLike js.Value, mapFn js.Value, thisArg js.Value) TypedArrayValue[T] {
+	res := js.Value(a).Call("from", arrayLike, mapFn, thisArg)
+	return TypedArrayValue[T](res)
+}
+
+// Of wraps the typed array of static method.
+//
+// https://developer.mozilla.org/en-US/docs/Web/JavaScript/Reference/Global_Objects/TypedArray/of
+func (a typedArrayJS[T]) Of(elements ...any) TypedArrayValue[T] {
+	res := js.Value(a).Call("of", elements...)
+	return TypedArrayValue[T](res)
+}
+
+// TypedArrayValue is an instance of a typed array with elements of type T.
+type TypedArrayValue[T TypedArrayElement] js.Value
+
+// Buffer wraps the typed array buffer property.
+//
+// If the typed array is a view of a SharedArrayBuffer the returned value
+// is not an ArrayBuffer, so use SharedBuffer to get it instead.
+//
+// https://developer.mozilla.org/en-US/docs/Web/JavaScript/Reference/Global_Objects/TypedArray/buffer
+func (a TypedArrayValue[T]) Buffer() ArrayBufferValue {
+	res := js.Value(a).Get("buffer")
+	return ArrayBufferValue(res)
+}
+
+// SharedBuffer returns the typed array buffer property
+// and true if it is a SharedArrayBuffer.
+//
+// https://developer.mozilla.org/en-US/docs/Web/JavaScript/Reference/Global_Objects/TypedArray/buffer
+func (a TypedArrayValue[T]) SharedBuffer() (SharedArrayBufferValue, bool) {
+	res := js.Value(a).Get("buffer")
+	if js.Value(SharedArrayBuffer).IsUndefined() || !res.InstanceOf(js.Value(SharedArrayBuffer)) {
+		return SharedArrayBufferValue(js.Undefined()), false
+	}
+	return SharedArrayBufferValue(res), true
+}
+
+// ByteLength wraps the typed array byteLength property.
+//
+// https://developer.mozilla.org/en-US/docs/Web/JavaScript/Reference/Global_Objects/TypedArray/byteLength
+func (a TypedArrayValue[T]) ByteLength() int {
+	return js.Value(a).Get("byteLength").Int()
+}
+
+// ByteOffset wraps the typed array byteOffset property.
+//
+// https://developer.mozilla.org/en-US/docs/Web/JavaScript/Reference/Global_Objects/TypedArray/byteOffset
+func (a TypedArrayValue[T]) ByteOffset() int {
+	return js.Value(a).Get("byteOffset").Int()
+}
+
+// Length wraps the typed array length property.
+//
+// https://developer.mozilla.org/en-US/docs/Web/JavaScript/Reference/Global_Objects/TypedArray/length
+func (a TypedArrayValue[T]) Length() int {
+	return js.Value(a).Get("length").Int()
+}
+
+// At wraps the typed array at instance method.
+//
+// https://developer.mozilla.org/en-US/docs/Web/JavaScript/Reference/Global_Objects/TypedArray/at
+func (a TypedArrayValue[T]) At(index int) js.Value {
+	return js.Value(a).Call("at", index)
+}
+
+// Fill wraps the typed array fill instance method.
+//
+// https://developer.mozilla.org/en-US/docs/Web/JavaScript/Reference/Global_Objects/TypedArray/fill
+func (a TypedArrayValue[T]) Fill(value any, start, end int) TypedArrayValue[T] {
+	res := js.Value(a).Call("fill", value, start, end)
+	return TypedArrayValue[T](res)
+}
+
+// Set wraps the typed array set instance method.
+//
+// https://developer.mozilla.org/en-US/docs/Web/JavaScript/Reference/Global_Objects/TypedArray/set
+func (a TypedArrayValue[T]) Set(array js.Value, targetOffset int) {
+	js.Value(a).Call("set", array, targetOffset)
+}
+
+// Slice wraps the typed array slice instance method.
+//
+// https://developer.mozilla.org/en-US/docs/Web/JavaScript/Reference/Global_Objects/TypedArray/slice
+func (a TypedArrayValue[T]) Slice(start, end int) TypedArrayValue[T] {
+	res := js.Value(a).Call("slice", start, end)
+	return TypedArrayValue[T](res)
+}
+
+// Subarray wraps the typed array subarray instance method.
+//
+// https://developer.mozilla.org/en-US/docs/Web/JavaScript/Reference/Global_Objects/TypedArray/subarray
+func (a TypedArrayValue[T]) Subarray(begin, end int) TypedArrayValue[T] {
+	res := js.Value(a).Call("subarray", begin, end)
+	return TypedArrayValue[T](res)
+}
+
+// TypedArrayFromSlice is a helper function that copies the given
+// slice into a new typed array with the matching element type.
+//
+// Signed and unsigned 64-bit integers are copied into
+// BigInt64Array and BigUint64Array respectively,
+// and Uint8Clamped values into a Uint8ClampedArray.
+func TypedArrayFromSlice[T TypedArrayElement](src []T) TypedArrayValue[T] {
+	dst := typedArrayFor[T]().New(len(src))
+	js.CopyBytesToJS(js.Value(bytesView(dst)), sliceBytes(src))
+	return dst
+}
+
+// SliceFromTypedArray is a helper function that copies
+// the given typed array into a new slice.
+func SliceFromTypedArray[T TypedArrayElement](src TypedArrayValue[T]) []T {
+	dst := make([]T, src.Length())
+	js.CopyBytesToGo(sliceBytes(dst), js.Value(bytesView(src)))
+	return dst
+}
+
+// typedArrayFor returns the typed array global object for the element type T.
+func typedArrayFor[T TypedArrayElement]() typedArrayJS[T] {
+	t := reflect.TypeOf((*T)(nil)).Elem()
+	if t == reflect.TypeOf(Uint8Clamped(0)) {
+		return typedArrayJS[T](Uint8ClampedArray)
+	}
+	var res js.Value
+	switch t.Kind() {
+	case reflect.Int8:
+		res = js.Value(Int8Array)
+	case reflect.Uint8:
+		res = js.Value(Uint8Array)
+	case reflect.Int16:
+		res = js.Value(Int16Array)
+	case reflect.Uint16:
+		res = js.Value(Uint16Array)
+	case reflect.Int32:
+		res = js.Value(Int32Array)
+	case reflect.Uint32:
+		res = js.Value(Uint32Array)
+	case reflect.Int64:
+		res = js.Value(BigInt64Array)
+	case reflect.Uint64:
+		res = js.Value(BigUint64Array)
+	case reflect.Float32:
+		res = js.Value(Float32Array)
+	case reflect.Float64:
+		res = js.Value(Float64Array)
+	}
+	return typedArrayJS[T](res)
+}
+
+// bytesView returns a Uint8Array that views the same bytes as the given typed array.
+func bytesView[T TypedArrayElement](src TypedArrayValue[T]) Uint8ArrayValue {
+	return Uint8Array.NewFromBuffer(src.Buffer(), src.ByteOffset(), src.ByteLength())
+}
+
+// sliceBytes returns a byte slice that shares memory with the given slice.
+//
+// Typed arrays use the platform byte order which is
+// little-endian in WebAssembly, so no conversion is needed.
+func sliceBytes[T TypedArrayElement](src []T) []byte {
+	if len(src) == 0 {
+		return nil
+	}
+	size := int(unsafe.Sizeof(src[0]))
+	return unsafe.Slice((*byte)(unsafe.Pointer(unsafe.SliceData(src))), len(src)*size)
+}
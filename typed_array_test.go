@@ -0,0 +1,105 @@
+//go:build js
+
+package goji
+
+import (
+	"math"
+	"syscall/js"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTypedArrayNew(t *testing.T) {
+	value := Float32Array.New(4)
+	assert.Equal(t, 4, value.Length())
+	assert.Equal(t, 16, value.ByteLength())
+	assert.Equal(t, 0, value.ByteOffset())
+	assert.Equal(t, 16, value.Buffer().ByteLength())
+	assert.Equal(t, 4, Float32Array.BytesPerElement())
+}
+
+func TestTypedArrayNewFromBuffer(t *testing.T) {
+	buffer := ArrayBuffer.New(16)
+
+	value := Int32Array.NewFromBuffer(buffer, 4, 2)
+	assert.Equal(t, 2, value.Length())
+	assert.Equal(t, 4, value.ByteOffset())
+	assert.Equal(t, 8, value.ByteLength())
+	assert.True(t, js.Value(value.Buffer()).Equal(js.Value(buffer)))
+}
+
+func TestTypedArraySubarrayAndSlice(t *testing.T) {
+	value := Int16Array.Of(1, 2, 3, 4)
+
+	sub := value.Subarray(1, 3)
+	assert.Equal(t, []int16{2, 3}, SliceFromTypedArray(sub))
+	assert.Equal(t, 2, sub.ByteOffset())
+
+	slice := value.Slice(1, 3)
+	assert.Equal(t, []int16{2, 3}, SliceFromTypedArray(slice))
+	assert.Equal(t, 0, slice.ByteOffset())
+
+	// subarrays share memory with the original array
+	sub.Set(js.Value(Int16Array.Of(5)), 0)
+	assert.Equal(t, 5, value.At(1).Int())
+	assert.Equal(t, 2, slice.At(0).Int())
+}
+
+func TestTypedArrayFromSlice(t *testing.T) {
+	floats := []float64{1.5, -2.25, math.MaxFloat64}
+	assert.Equal(t, floats, SliceFromTypedArray(TypedArrayFromSlice(floats)))
+
+	singles := []float32{1.5, -2.25}
+	assert.Equal(t, singles, SliceFromTypedArray(TypedArrayFromSlice(singles)))
+
+	ints := []int32{1, -2, math.MaxInt32}
+	value := TypedArrayFromSlice(ints)
+	require.True(t, js.Value(value).InstanceOf(js.Value(Int32Array)))
+	assert.Equal(t, -2, value.At(1).Int())
+	assert.Equal(t, ints, SliceFromTypedArray(value))
+
+	bigs := []int64{math.MinInt64, 0, math.MaxInt64}
+	big := TypedArrayFromSlice(bigs)
+	require.True(t, js.Value(big).InstanceOf(js.Value(BigInt64Array)))
+	assert.Equal(t, "9223372036854775807", bigIntString(big.At(2)))
+	assert.Equal(t, bigs, SliceFromTypedArray(big))
+
+	ubigs := []uint64{0, math.MaxUint64}
+	assert.Equal(t, ubigs, SliceFromTypedArray(TypedArrayFromSlice(ubigs)))
+}
+
+func TestTypedArrayFromSliceNamedType(t *testing.T) {
+	type sample int16
+
+	samples := []sample{1, -1}
+	value := TypedArrayFromSlice(samples)
+	require.True(t, js.Value(value).InstanceOf(js.Value(Int16Array)))
+	assert.Equal(t, samples, SliceFromTypedArray(value))
+}
+
+func TestTypedArrayFromEmptySlice(t *testing.T) {
+	value := TypedArrayFromSlice([]uint16{})
+	assert.Equal(t, 0, value.Length())
+	assert.Equal(t, []uint16{}, SliceFromTypedArray(value))
+}
+
+func TestUint8ClampedArray(t *testing.T) {
+	value := Uint8ClampedArray.Of(300, -5, 7)
+	assert.Equal(t, []Uint8Clamped{255, 0, 7}, SliceFromTypedArray(value))
+
+	copied := TypedArrayFromSlice([]Uint8Clamped{1, 2})
+	assert.True(t, js.Value(copied).InstanceOf(js.Value(Uint8ClampedArray)))
+}
+
+func TestTypedArraySharedBuffer(t *testing.T) {
+	_, ok := Int32Array.New(2).SharedBuffer()
+	assert.False(t, ok)
+
+	shared := SharedArrayBuffer.New(8)
+	value := Int32Array.New(js.Value(shared))
+	buffer, ok := value.SharedBuffer()
+	require.True(t, ok)
+	assert.True(t, js.Value(buffer).Equal(js.Value(shared)))
+}
@@ -0,0 +1,56 @@
+//go:build js
+
+package goji
+
+import "syscall/js"
+
+// TypedEventTarget is an instance of EventTarget whose events are
+// CustomEvents with a detail of type T.
+//
+// The details are marshalled with MarshalJS and unmarshalled with UnmarshalJS,
+// so JS code can dispatch and listen for the same events.
+type TypedEventTarget[T any] EventTargetValue
+
+// NewTypedEventTarget returns a new TypedEventTarget backed by a new EventTarget.
+func NewTypedEventTarget[T any]() TypedEventTarget[T] {
+	return TypedEventTarget[T](EventTarget.New())
+}
+
+// EventTarget returns the underlying EventTarget.
+func (e TypedEventTarget[T]) EventTarget() EventTargetValue {
+	return EventTargetValue(e)
+}
+
+// Emit dispatches a new CustomEvent of the given type with the given detail.
+//
+// The return value is false if the event is cancelable and at least
+// one of the listeners called Event.preventDefault. An error is
+// returned if the detail cannot be marshalled.
+func (e TypedEventTarget[T]) Emit(eventType string, detail T, opts ...eventOption) (bool, error) {
+	value, err := MarshalJS(detail)
+	if err != nil {
+		return false, err
+	}
+	event := CustomEvent.New(eventType, value, opts...)
+	return e.EventTarget().DispatchEvent(js.Value(event)), nil
+}
+
+// On adds a new event listener that calls the given func with the decoded
+// detail of each event of the given type and returns a subscription that
+// can be used to remove the listener.
+//
+// Events with a detail that cannot be decoded into T are not passed to fn.
+// Instead onError is called with the decoding error, or the event is
+// skipped if onError is nil.
+func (e TypedEventTarget[T]) On(eventType string, fn func(detail T), onError func(err error), opts ...eventListenerOption) *Subscription {
+	return e.EventTarget().Subscribe(eventType, func(event EventValue) {
+		var detail T
+		if err := UnmarshalJS(CustomEventValue(event).Detail(), &detail); err != nil {
+			if onError != nil {
+				onError(err)
+			}
+			return
+		}
+		fn(detail)
+	}, opts...)
+}
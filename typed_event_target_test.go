@@ -0,0 +1,75 @@
+//go:build js
+
+package goji
+
+import (
+	"syscall/js"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTypedEventTarget(t *testing.T) {
+	CheckLeaks(t)
+
+	target := NewTypedEventTarget[customType]()
+
+	var received []customType
+	sub := target.On("test", func(detail customType) {
+		received = append(received, detail)
+	}, nil)
+	defer sub.Close()
+
+	expect := customType{Name: "Alice", Age: 42}
+	dispatched, err := target.Emit("test", expect)
+	require.NoError(t, err)
+	assert.True(t, dispatched)
+	assert.Equal(t, []customType{expect}, received)
+}
+
+func TestTypedEventTargetFromJS(t *testing.T) {
+	CheckLeaks(t)
+
+	target := NewTypedEventTarget[customType]()
+
+	var received []customType
+	var errs []error
+	sub := target.On("test", func(detail customType) {
+		received = append(received, detail)
+	}, func(err error) {
+		errs = append(errs, err)
+	})
+	defer sub.Close()
+
+	// events dispatched from JS are decoded
+	dispatch := js.Global().Call("eval", `(target, detail) => {
+		target.dispatchEvent(new CustomEvent("test", { detail }));
+	}`)
+	dispatch.Invoke(js.Value(target), js.ValueOf(map[string]any{"name": "Bob", "Age": 41}))
+
+	// events that cannot be decoded are reported
+	dispatch.Invoke(js.Value(target), js.ValueOf("invalid"))
+
+	assert.Equal(t, []customType{{Name: "Bob", Age: 41}}, received)
+	require.Len(t, errs, 1)
+	var typeErr *UnmarshalTypeError
+	assert.ErrorAs(t, errs[0], &typeErr)
+}
+
+func TestTypedEventTargetToJS(t *testing.T) {
+	target := NewTypedEventTarget[customType]()
+
+	var detail js.Value
+	listener := EventListener(func(event EventValue) {
+		detail = CustomEventValue(event).Detail()
+	})
+	defer ReleaseFunc(listener)
+
+	target.EventTarget().AddEventListener("test", listener.Value)
+
+	_, err := target.Emit("test", customType{Name: "Alice", Age: 42})
+	require.NoError(t, err)
+	assert.Equal(t, "Alice", detail.Get("name").String())
+	assert.Equal(t, 42, detail.Get("Age").Int())
+}
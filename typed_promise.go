@@ -0,0 +1,51 @@
+//go:build js
+
+package goji
+
+import (
+	"context"
+	"syscall/js"
+)
+
+// TypedPromise is an instance of Promise that resolves to a value of type T.
+type TypedPromise[T any] PromiseValue
+
+// Value returns the underlying PromiseValue.
+func (p TypedPromise[T]) Value() PromiseValue {
+	return PromiseValue(p)
+}
+
+// Await waits for the promise to resolve or reject and returns
+// the decoded result and an error value.
+func (p TypedPromise[T]) Await(ctx context.Context) (T, error) {
+	return AwaitAs[T](ctx, PromiseValue(p))
+}
+
+// AwaitAs is a helper function that waits for a promise to resolve or reject
+// and returns the result decoded into a value of type T.
+//
+// The result is decoded with UnmarshalJS, so the wrapper types
+// in this module are converted directly.
+func AwaitAs[T any](ctx context.Context, promise PromiseValue) (T, error) {
+	var out T
+	res, err := AwaitContext(ctx, promise)
+	if err != nil {
+		return out, err
+	}
+	value := js.Undefined()
+	if len(res) > 0 {
+		value = res[0]
+	}
+	err = UnmarshalJS(value, &out)
+	return out, err
+}
+
+// PromiseFrom returns a promise that resolves to the given value
+// marshalled with MarshalJS, or rejects if the value cannot be marshalled.
+func PromiseFrom[T any](value T) TypedPromise[T] {
+	res, err := MarshalJS(value)
+	if err != nil {
+		return TypedPromise[T](Promise.Reject(js.Value(WrapError(err))))
+	}
+	return TypedPromise[T](Promise.Resolve(res))
+}
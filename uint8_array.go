@@ -5,33 +5,16 @@ package goji
 import "syscall/js"
 
 func init() {
-	Uint8Array = uint8ArrayJS(js.Global().Get("Uint8Array"))
+	Uint8Array = typedArrayJS[uint8](js.Global().Get("Uint8Array"))
 }
 
-type uint8ArrayJS js.Value
-
 // Uint8Array is a wrapper for the Uint8Array global object.
 //
 // https://developer.mozilla.org/en-US/docs/Web/JavaScript/Reference/Global_Objects/Uint8Array
-var Uint8Array uint8ArrayJS
-
-// New is a wrapper for the Uint8Array constructor.
-//
-// https://developer.mozilla.org/en-US/docs/Web/JavaScript/Reference/Global_Objects/Uint8Array/Uint8Array
-func (a uint8ArrayJS) New(length any) Uint8ArrayValue {
-	res := js.Value(a).New(length)
-	return Uint8ArrayValue(res)
-}
+var Uint8Array typedArrayJS[uint8]
 
 // Uint8ArrayValue is an instance of Uint8Array.
-type Uint8ArrayValue js.Value
-
-// Length is a wrapper for Uint8Array length property.
-//
-// https://developer.mozilla.org/en-US/docs/Web/JavaScript/Reference/Global_Objects/TypedArray/length
-func (a Uint8ArrayValue) Length() int {
-	return js.Value(a).Get("length").Int()
-}
+type Uint8ArrayValue = TypedArrayValue[uint8]
 
 // Uint8ArrayFromBytes is a helper function that copies the given
 // byte slice into a new Uint8Array.
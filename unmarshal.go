@@ -0,0 +1,619 @@
+//go:build js
+
+package goji
+
+import (
+	"math/big"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"syscall/js"
+	"time"
+)
+
+// UnmarshalerJS is implemented by types that can unmarshal a js.Value into themselves.
+type UnmarshalerJS interface {
+	UnmarshalJS(value js.Value) error
+}
+
+// InvalidUnmarshalError is returned by UnmarshalJS when
+// the given value is not a non-nil pointer.
+type InvalidUnmarshalError struct {
+	Type reflect.Type
+}
+
+func (e *InvalidUnmarshalError) Error() string {
+	if e.Type == nil {
+		return "goji: UnmarshalJS(nil)"
+	}
+	if e.Type.Kind() != reflect.Pointer {
+		return "goji: UnmarshalJS(non-pointer " + e.Type.String() + ")"
+	}
+	return "goji: UnmarshalJS(nil " + e.Type.String() + ")"
+}
+
+// UnmarshalTypeError is returned by UnmarshalJS when a js.Value
+// cannot be unmarshalled into a value of a specific Go type.
+type UnmarshalTypeError struct {
+	// Value is a description of the JS value.
+	Value string
+	// Type is the Go type it could not be assigned to.
+	Type reflect.Type
+}
+
+func (e *UnmarshalTypeError) Error() string {
+	return "goji: cannot unmarshal " + e.Value + " into Go value of type " + e.Type.String()
+}
+
+// UnmarshalJS unmarshals the given js.Value into the given pointer.
+//
+// It is the inverse of MarshalJS. Struct fields are matched to object
+// properties by name, preferring an exact match over a case-insensitive match.
+// Values decoded into an interface value are mapped as follows:
+//
+//   - undefined and null to nil
+//   - boolean to bool
+//   - number to float64
+//   - string to string
+//   - BigInt to *big.Int
+//   - Uint8Array to []byte
+//   - Date to time.Time
+//   - Array and Set to []any
+//   - Map to map[any]any
+//   - Object to map[string]any
+//   - all other values to js.Value
+//
+// Cyclic values can be decoded into pointers, maps and interface values.
+func UnmarshalJS(value js.Value, v any) error {
+	rv := reflect.ValueOf(v)
+	if rv.Kind() != reflect.Pointer || rv.IsNil() {
+		return &InvalidUnmarshalError{reflect.TypeOf(v)}
+	}
+	var d decoder
+	return d.decode(value, rv.Elem())
+}
+
+// MustUnmarshalJS unmarshals the given js.Value into the given pointer or panics.
+func MustUnmarshalJS(value js.Value, v any) {
+	err := UnmarshalJS(value, v)
+	if err != nil {
+		panic(err)
+	}
+}
+
+// ancestor is a JS object that is being decoded.
+type ancestor struct {
+	value js.Value
+	// ref is the Go pointer or map the object is decoded into.
+	ref reflect.Value
+}
+
+// decoder contains the state used to decode a value.
+type decoder struct {
+	// ancestors contains the JS objects that are being
+	// decoded and is used to resolve cyclic values.
+	ancestors []ancestor
+}
+
+func (d *decoder) decode(value js.Value, v reflect.Value) error {
+	t := v.Type()
+	if t.Kind() != reflect.Pointer && v.CanAddr() && reflect.PointerTo(t).Implements(unmarshalerJSType) {
+		return v.Addr().Interface().(UnmarshalerJS).UnmarshalJS(value)
+	}
+
+	switch t {
+	case jsValueType:
+		v.Set(reflect.ValueOf(value))
+		return nil
+	case timeType:
+		return d.decodeTime(value, v)
+	case bigIntType:
+		return d.decodeBigInt(value, v)
+	}
+	if t.Kind() == reflect.Struct && t.ConvertibleTo(jsValueType) {
+		v.Set(reflect.ValueOf(value).Convert(t))
+		return nil
+	}
+
+	if isBigInt(value) {
+		return d.decodeBigIntNumber(value, v)
+	}
+	if value.IsUndefined() || value.IsNull() {
+		switch t.Kind() {
+		case reflect.Interface, reflect.Pointer, reflect.Map, reflect.Slice:
+			v.SetZero()
+		}
+		return nil
+	}
+
+	switch t.Kind() {
+	case reflect.Bool:
+		if value.Type() != js.TypeBoolean {
+			return typeError(value, t)
+		}
+		v.SetBool(value.Bool())
+		return nil
+
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		if value.Type() != js.TypeNumber {
+			return typeError(value, t)
+		}
+		n := value.Float()
+		if n != float64(int64(n)) || v.OverflowInt(int64(n)) {
+			return typeError(value, t)
+		}
+		v.SetInt(int64(n))
+		return nil
+
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		if value.Type() != js.TypeNumber {
+			return typeError(value, t)
+		}
+		n := value.Float()
+		if n < 0 || n != float64(uint64(n)) || v.OverflowUint(uint64(n)) {
+			return typeError(value, t)
+		}
+		v.SetUint(uint64(n))
+		return nil
+
+	case reflect.Float32, reflect.Float64:
+		if value.Type() != js.TypeNumber {
+			return typeError(value, t)
+		}
+		v.SetFloat(value.Float())
+		return nil
+
+	case reflect.String:
+		if value.Type() != js.TypeString {
+			return typeError(value, t)
+		}
+		v.SetString(value.String())
+		return nil
+
+	case reflect.Interface:
+		if t.NumMethod() != 0 {
+			return typeError(value, t)
+		}
+		if ref, ok := d.ancestor(value); ok {
+			if !ref.IsValid() {
+				return typeError(value, t)
+			}
+			v.Set(ref)
+			return nil
+		}
+		res, err := d.decodeAny(value)
+		if err != nil {
+			return err
+		}
+		if res == nil {
+			v.SetZero()
+		} else {
+			v.Set(reflect.ValueOf(res))
+		}
+		return nil
+
+	case reflect.Pointer:
+		if ref, ok := d.ancestor(value); ok {
+			if ref.IsValid() && ref.Type() == t {
+				v.Set(ref)
+				return nil
+			}
+			return typeError(value, t)
+		}
+		if v.IsNil() {
+			v.Set(reflect.New(t.Elem()))
+		}
+		if value.Type() == js.TypeObject {
+			d.push(value, v)
+			defer d.pop()
+		}
+		return d.decode(value, v.Elem())
+
+	case reflect.Slice:
+		if t.Elem().Kind() == reflect.Uint8 && isByteSource(value) {
+			v.SetBytes(bytesFromJS(value))
+			return nil
+		}
+		return d.decodeArray(value, v)
+
+	case reflect.Array:
+		return d.decodeArray(value, v)
+
+	case reflect.Map:
+		return d.decodeMap(value, v)
+
+	case reflect.Struct:
+		return d.decodeStruct(value, v)
+
+	default:
+		return typeError(value, t)
+	}
+}
+
+func (d *decoder) decodeTime(value js.Value, v reflect.Value) error {
+	switch {
+	case value.IsUndefined() || value.IsNull():
+		return nil
+
+	case value.InstanceOf(js.Value(Date)):
+		v.Set(reflect.ValueOf(DateValue(value).Time()))
+		return nil
+
+	case value.Type() == js.TypeNumber:
+		v.Set(reflect.ValueOf(time.UnixMilli(int64(value.Float()))))
+		return nil
+
+	case value.Type() == js.TypeString:
+		tm, err := time.Parse(time.RFC3339Nano, value.String())
+		if err != nil {
+			return err
+		}
+		v.Set(reflect.ValueOf(tm))
+		return nil
+
+	default:
+		return typeError(value, v.Type())
+	}
+}
+
+func (d *decoder) decodeBigInt(value js.Value, v reflect.Value) error {
+	if value.IsUndefined() || value.IsNull() {
+		return nil
+	}
+	if !isBigInt(value) && value.Type() != js.TypeNumber && value.Type() != js.TypeString {
+		return typeError(value, v.Type())
+	}
+	n, ok := new(big.Int).SetString(bigIntString(value), 10)
+	if !ok {
+		return typeError(value, v.Type())
+	}
+	v.Set(reflect.ValueOf(n).Elem())
+	return nil
+}
+
+// decodeBigIntNumber decodes a BigInt primitive into a Go number.
+func (d *decoder) decodeBigIntNumber(value js.Value, v reflect.Value) error {
+	text := bigIntString(value)
+	switch v.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		n, err := strconv.ParseInt(text, 10, 64)
+		if err != nil || v.OverflowInt(n) {
+			return typeError(value, v.Type())
+		}
+		v.SetInt(n)
+		return nil
+
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		n, err := strconv.ParseUint(text, 10, 64)
+		if err != nil || v.OverflowUint(n) {
+			return typeError(value, v.Type())
+		}
+		v.SetUint(n)
+		return nil
+
+	case reflect.Float32, reflect.Float64:
+		n, err := strconv.ParseFloat(text, 64)
+		if err != nil {
+			return typeError(value, v.Type())
+		}
+		v.SetFloat(n)
+		return nil
+
+	case reflect.Interface:
+		if v.NumMethod() != 0 {
+			return typeError(value, v.Type())
+		}
+		n, _ := new(big.Int).SetString(text, 10)
+		v.Set(reflect.ValueOf(n))
+		return nil
+
+	case reflect.Pointer:
+		if v.IsNil() {
+			v.Set(reflect.New(v.Type().Elem()))
+		}
+		return d.decode(value, v.Elem())
+
+	default:
+		return typeError(value, v.Type())
+	}
+}
+
+func (d *decoder) decodeArray(value js.Value, v reflect.Value) error {
+	switch {
+	case Array.IsArray(value):
+		// decoded below
+	case value.InstanceOf(js.Value(Set)):
+		value = js.Value(Array.From(value, js.Undefined(), js.Undefined()))
+	case !ArrayBuffer.IsView(value):
+		return typeError(value, v.Type())
+	}
+	if !d.isParent(value) {
+		if _, ok := d.ancestor(value); ok {
+			return typeError(value, v.Type())
+		}
+		d.push(value, reflect.Value{})
+		defer d.pop()
+	}
+
+	length := value.Length()
+	if v.Kind() == reflect.Slice {
+		v.Set(reflect.MakeSlice(v.Type(), length, length))
+	}
+	for i := 0; i < v.Len(); i++ {
+		if i >= length {
+			v.Index(i).SetZero()
+			continue
+		}
+		if err := d.decode(value.Index(i), v.Index(i)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (d *decoder) decodeMap(value js.Value, v reflect.Value) error {
+	if value.Type() != js.TypeObject {
+		return typeError(value, v.Type())
+	}
+	t := v.Type()
+	if ref, ok := d.ancestor(value); ok && !d.isParent(value) {
+		if ref.IsValid() && ref.Type() == t {
+			v.Set(ref)
+			return nil
+		}
+		return typeError(value, t)
+	}
+	if v.IsNil() {
+		v.Set(reflect.MakeMap(t))
+	}
+	d.push(value, v)
+	defer d.pop()
+
+	if value.InstanceOf(js.Value(Map)) {
+		entries := Array.From(value, js.Undefined(), js.Undefined())
+		for i := 0; i < entries.Length(); i++ {
+			entry := entries.At(i)
+			key := reflect.New(t.Key()).Elem()
+			if err := d.decode(entry.Index(0), key); err != nil {
+				return err
+			}
+			elem := reflect.New(t.Elem()).Elem()
+			if err := d.decode(entry.Index(1), elem); err != nil {
+				return err
+			}
+			v.SetMapIndex(key, elem)
+		}
+		return nil
+	}
+
+	keys := jsObject.Call("keys", value)
+	for i := 0; i < keys.Length(); i++ {
+		name := keys.Index(i).String()
+		key := reflect.New(t.Key()).Elem()
+		if err := setMapKey(name, key); err != nil {
+			return err
+		}
+		elem := reflect.New(t.Elem()).Elem()
+		if err := d.decode(value.Get(name), elem); err != nil {
+			return err
+		}
+		v.SetMapIndex(key, elem)
+	}
+	return nil
+}
+
+func (d *decoder) decodeStruct(value js.Value, v reflect.Value) error {
+	if value.Type() != js.TypeObject {
+		return typeError(value, v.Type())
+	}
+	if !d.isParent(value) {
+		if _, ok := d.ancestor(value); ok {
+			return typeError(value, v.Type())
+		}
+		d.push(value, reflect.Value{})
+		defer d.pop()
+	}
+
+	var keys []string
+	for _, f := range cachedFields(v.Type()) {
+		// inherited properties such as constructor are not fields
+		prop := js.Undefined()
+		if hasOwnProperty(value, f.name) {
+			prop = value.Get(f.name)
+		}
+		if prop.IsUndefined() {
+			// fallback to a case-insensitive match
+			if keys == nil {
+				keys = objectKeys(value)
+			}
+			for _, k := range keys {
+				if strings.EqualFold(k, f.name) {
+					prop = value.Get(k)
+					break
+				}
+			}
+		}
+		if prop.IsUndefined() {
+			continue
+		}
+		fv, _ := fieldByIndex(v, f.index, true)
+		if err := d.decode(prop, fv); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// decodeAny decodes the given value into the Go value
+// that best represents it.
+func (d *decoder) decodeAny(value js.Value) (any, error) {
+	if isBigInt(value) {
+		n, _ := new(big.Int).SetString(bigIntString(value), 10)
+		return n, nil
+	}
+	switch value.Type() {
+	case js.TypeUndefined, js.TypeNull:
+		return nil, nil
+	case js.TypeBoolean:
+		return value.Bool(), nil
+	case js.TypeNumber:
+		return value.Float(), nil
+	case js.TypeString:
+		return value.String(), nil
+	case js.TypeObject:
+		// handled below
+	default:
+		return value, nil
+	}
+
+	var res any
+	var err error
+	switch {
+	case value.InstanceOf(js.Value(Uint8Array)):
+		res = bytesFromJS(value)
+	case value.InstanceOf(js.Value(Date)):
+		res = DateValue(value).Time()
+	case Array.IsArray(value) || value.InstanceOf(js.Value(Set)):
+		var out []any
+		err = d.decode(value, reflect.ValueOf(&out).Elem())
+		res = out
+	case value.InstanceOf(js.Value(Map)):
+		var out map[any]any
+		err = d.decode(value, reflect.ValueOf(&out).Elem())
+		res = out
+	case jsObject.Call("getPrototypeOf", value).Equal(jsObject.Get("prototype")) ||
+		jsObject.Call("getPrototypeOf", value).IsNull():
+		var out map[string]any
+		err = d.decode(value, reflect.ValueOf(&out).Elem())
+		res = out
+	default:
+		res = value
+	}
+	return res, err
+}
+
+// ancestor returns the Go value for the given JS object
+// if it is currently being decoded.
+func (d *decoder) ancestor(value js.Value) (reflect.Value, bool) {
+	if value.Type() != js.TypeObject {
+		return reflect.Value{}, false
+	}
+	for i := len(d.ancestors) - 1; i >= 0; i-- {
+		if d.ancestors[i].value.Equal(value) {
+			return d.ancestors[i].ref, true
+		}
+	}
+	return reflect.Value{}, false
+}
+
+// isParent returns true if the given JS object is the last
+// ancestor, such as when a pointer is decoded into its element.
+func (d *decoder) isParent(value js.Value) bool {
+	return len(d.ancestors) > 0 && d.ancestors[len(d.ancestors)-1].value.Equal(value)
+}
+
+func (d *decoder) push(value js.Value, ref reflect.Value) {
+	d.ancestors = append(d.ancestors, ancestor{value, ref})
+}
+
+func (d *decoder) pop() {
+	d.ancestors = d.ancestors[:len(d.ancestors)-1]
+}
+
+// setMapKey sets the map key to the given object property name.
+func setMapKey(name string, key reflect.Value) error {
+	switch key.Kind() {
+	case reflect.String:
+		key.SetString(name)
+		return nil
+
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		n, err := strconv.ParseInt(name, 10, 64)
+		if err != nil || key.OverflowInt(n) {
+			return &UnmarshalTypeError{Value: "property " + strconv.Quote(name), Type: key.Type()}
+		}
+		key.SetInt(n)
+		return nil
+
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		n, err := strconv.ParseUint(name, 10, 64)
+		if err != nil || key.OverflowUint(n) {
+			return &UnmarshalTypeError{Value: "property " + strconv.Quote(name), Type: key.Type()}
+		}
+		key.SetUint(n)
+		return nil
+
+	case reflect.Interface:
+		if key.NumMethod() != 0 {
+			return &UnmarshalTypeError{Value: "property " + strconv.Quote(name), Type: key.Type()}
+		}
+		key.Set(reflect.ValueOf(name))
+		return nil
+
+	default:
+		return &UnmarshalTypeError{Value: "property " + strconv.Quote(name), Type: key.Type()}
+	}
+}
+
+// objectKeys returns the own enumerable property names of the given object.
+func objectKeys(value js.Value) []string {
+	keys := jsObject.Call("keys", value)
+	res := make([]string, keys.Length())
+	for i := range res {
+		res[i] = keys.Index(i).String()
+	}
+	return res
+}
+
+// isByteSource returns true if the given value is an ArrayBuffer or a Uint8Array.
+func isByteSource(value js.Value) bool {
+	return value.InstanceOf(js.Value(Uint8Array)) || value.InstanceOf(js.Value(ArrayBuffer))
+}
+
+// bytesFromJS copies the bytes from the given ArrayBuffer or Uint8Array.
+func bytesFromJS(value js.Value) []byte {
+	if value.InstanceOf(js.Value(ArrayBuffer)) {
+		value = js.Value(Uint8Array.New(value))
+	}
+	return BytesFromUint8Array(Uint8ArrayValue(value))
+}
+
+// typeOf returns a JS function that returns the typeof of its argument.
+//
+// It is compiled once, so pages with a Content Security Policy
+// must allow 'unsafe-eval' for that single compilation.
+var typeOf = sync.OnceValue(func() js.Value {
+	return js.Global().Get("Function").New("v", "return typeof v")
+})
+
+// hasOwnProperty returns true if the given object has
+// an own property with the given name.
+func hasOwnProperty(value js.Value, name string) bool {
+	return jsHasOwnProperty.Call("call", value, name).Bool()
+}
+
+// isBigInt returns true if the given value is a BigInt primitive.
+//
+// The js.Value Type method panics for BigInt values
+// so the typeof operator is used to detect them.
+func isBigInt(value js.Value) bool {
+	return typeOf().Invoke(value).String() == "bigint"
+}
+
+// bigIntString returns the decimal string of the given BigInt or number.
+//
+// Methods cannot be called on BigInt values with js.Value Call
+// so the String global is used to convert them.
+func bigIntString(value js.Value) string {
+	return jsString.Invoke(value).String()
+}
+
+// typeError returns an UnmarshalTypeError for the given value and type.
+func typeError(value js.Value, t reflect.Type) error {
+	desc := "BigInt"
+	if !isBigInt(value) {
+		desc = value.Type().String()
+	}
+	return &UnmarshalTypeError{Value: desc, Type: t}
+}
@@ -0,0 +1,224 @@
+//go:build js
+
+package goji
+
+import (
+	"encoding/json"
+	"math/big"
+	"syscall/js"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// marshalerType is a type used to test custom marshalling
+type marshalerType struct {
+	Value string
+}
+
+func (m marshalerType) MarshalJS() (js.Value, error) {
+	return js.ValueOf(m.Value), nil
+}
+
+func (m *marshalerType) UnmarshalJS(value js.Value) error {
+	m.Value = value.String()
+	return nil
+}
+
+// unmarshalJSON is the JSON round trip previously used by UnmarshalJS.
+func unmarshalJSON(value js.Value, v any) error {
+	text := JSON.Stringify(value)
+	return json.Unmarshal([]byte(text), v)
+}
+
+func TestUnmarshalJSStructTags(t *testing.T) {
+	value := JSON.Parse(`{"name":"Alice","nickname":"Al","Secret":"secret","legacy":"legacy"}`)
+
+	var actual taggedType
+	err := UnmarshalJS(value, &actual)
+	require.NoError(t, err)
+
+	assert.Equal(t, taggedType{Name: "Alice", Nickname: "Al", Legacy: "legacy"}, actual)
+}
+
+func TestUnmarshalJSCaseInsensitive(t *testing.T) {
+	value := JSON.Parse(`{"NAME":"Alice","age":42}`)
+
+	var actual customType
+	err := UnmarshalJS(value, &actual)
+	require.NoError(t, err)
+
+	assert.Equal(t, customType{Name: "Alice", Age: 42}, actual)
+}
+
+func TestUnmarshalJSInheritedProperties(t *testing.T) {
+	type inherited struct {
+		Constructor string `js:"constructor"`
+		ToString    string `js:"toString"`
+		Name        string `js:"name"`
+	}
+	value := JSON.Parse(`{"name":"Alice"}`)
+
+	var actual inherited
+	err := UnmarshalJS(value, &actual)
+	require.NoError(t, err)
+
+	assert.Equal(t, inherited{Name: "Alice"}, actual)
+}
+
+func TestUnmarshalJSRoundTrip(t *testing.T) {
+	type roundTrip struct {
+		Bytes  []byte          `js:"bytes"`
+		Time   time.Time       `js:"time"`
+		Int    int64           `js:"int"`
+		Big    *big.Int        `js:"big"`
+		Object map[string]int  `js:"object"`
+		Map    map[int]string  `js:"map"`
+		Array  [2]bool         `js:"array"`
+		Ptr    *string         `js:"ptr"`
+		Custom marshalerType   `js:"custom"`
+		Value  js.Value        `js:"value"`
+		Array2 ArrayValue      `js:"array2"`
+		Nested []taggedType    `js:"nested"`
+		Any    map[string]any  `js:"any"`
+		Empty  *roundTripEmpty `js:"empty"`
+	}
+	str := "ptr"
+	expect := roundTrip{
+		Bytes:  []byte{1, 2, 3},
+		Time:   time.UnixMilli(time.Now().UnixMilli()),
+		Int:    9007199254740993,
+		Big:    big.NewInt(42),
+		Object: map[string]int{"a": 1},
+		Map:    map[int]string{1: "a"},
+		Array:  [2]bool{true, false},
+		Ptr:    &str,
+		Custom: marshalerType{Value: "custom"},
+		Value:  js.ValueOf("value"),
+		Array2: Array.Of(1, 2),
+		Nested: []taggedType{{Name: "Alice"}},
+		Any:    map[string]any{"a": "b", "c": 1.5, "d": []any{true, nil}},
+	}
+
+	value, err := MarshalJS(expect)
+	require.NoError(t, err)
+
+	var actual roundTrip
+	err = UnmarshalJS(value, &actual)
+	require.NoError(t, err)
+
+	assert.Equal(t, expect.Bytes, actual.Bytes)
+	assert.True(t, expect.Time.Equal(actual.Time))
+	assert.Equal(t, expect.Int, actual.Int)
+	assert.Equal(t, expect.Big.String(), actual.Big.String())
+	assert.Equal(t, expect.Object, actual.Object)
+	assert.Equal(t, expect.Map, actual.Map)
+	assert.Equal(t, expect.Array, actual.Array)
+	assert.Equal(t, *expect.Ptr, *actual.Ptr)
+	assert.Equal(t, expect.Custom, actual.Custom)
+	assert.True(t, expect.Value.Equal(actual.Value))
+	assert.True(t, js.Value(expect.Array2).Equal(js.Value(actual.Array2)))
+	assert.Equal(t, expect.Nested, actual.Nested)
+	assert.Equal(t, expect.Any, actual.Any)
+	assert.Nil(t, actual.Empty)
+}
+
+// roundTripEmpty is a type used to test nil pointers
+type roundTripEmpty struct{}
+
+func TestUnmarshalJSAny(t *testing.T) {
+	value := js.Global().Call("eval", `({
+		bool: true,
+		number: 1.5,
+		string: "a",
+		bigint: 10n,
+		bytes: new Uint8Array([1, 2]),
+		date: new Date(1000),
+		set: new Set(["a"]),
+		map: new Map([[1, "a"]]),
+		null: null,
+	})`)
+
+	var actual any
+	err := UnmarshalJS(value, &actual)
+	require.NoError(t, err)
+
+	res, ok := actual.(map[string]any)
+	require.True(t, ok)
+	assert.Equal(t, true, res["bool"])
+	assert.Equal(t, 1.5, res["number"])
+	assert.Equal(t, "a", res["string"])
+	assert.Equal(t, big.NewInt(10), res["bigint"])
+	assert.Equal(t, []byte{1, 2}, res["bytes"])
+	assert.Equal(t, time.UnixMilli(1000), res["date"])
+	assert.Equal(t, []any{"a"}, res["set"])
+	assert.Equal(t, map[any]any{1.0: "a"}, res["map"])
+	assert.Nil(t, res["null"])
+}
+
+func TestUnmarshalJSCycle(t *testing.T) {
+	type node struct {
+		Name string `js:"name"`
+		Next *node  `js:"next"`
+	}
+	value := js.Global().Call("eval", `(() => {
+		const node = { name: "a" };
+		node.next = node;
+		return node;
+	})()`)
+
+	var actual *node
+	err := UnmarshalJS(value, &actual)
+	require.NoError(t, err)
+
+	assert.Equal(t, "a", actual.Name)
+	assert.Same(t, actual, actual.Next)
+
+	var anyValue any
+	err = UnmarshalJS(value, &anyValue)
+	require.NoError(t, err)
+
+	res := anyValue.(map[string]any)
+	assert.Equal(t, "a", res["name"])
+}
+
+func TestUnmarshalJSTypeError(t *testing.T) {
+	var actual int
+	err := UnmarshalJS(js.ValueOf("a"), &actual)
+	var typeErr *UnmarshalTypeError
+	require.ErrorAs(t, err, &typeErr)
+
+	err = UnmarshalJS(js.ValueOf(1.5), &actual)
+	require.ErrorAs(t, err, &typeErr)
+}
+
+func TestUnmarshalJSInvalid(t *testing.T) {
+	var actual int
+	err := UnmarshalJS(js.ValueOf(1), actual)
+	var invalidErr *InvalidUnmarshalError
+	require.ErrorAs(t, err, &invalidErr)
+}
+
+func BenchmarkUnmarshalJS(b *testing.B) {
+	value := MustMarshalJS(newBenchValue())
+	for i := 0; i < b.N; i++ {
+		var out benchType
+		err := UnmarshalJS(value, &out)
+		if err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func BenchmarkUnmarshalJSON(b *testing.B) {
+	value := MustMarshalJS(newBenchValue())
+	for i := 0; i < b.N; i++ {
+		var out benchType
+		err := unmarshalJSON(value, &out)
+		if err != nil {
+			b.Fatal(err)
+		}
+	}
+}
@@ -0,0 +1,34 @@
+//go:build js
+
+package goji
+
+import "syscall/js"
+
+func init() {
+	WeakRef = weakRefJS(js.Global().Get("WeakRef"))
+}
+
+type weakRefJS js.Value
+
+// WeakRef is a wrapper for the WeakRef global object.
+//
+// https://developer.mozilla.org/en-US/docs/Web/JavaScript/Reference/Global_Objects/WeakRef
+var WeakRef weakRefJS
+
+// WeakRefValue is an instance of WeakRef.
+type WeakRefValue js.Value
+
+// New wraps the WeakRef constructor.
+//
+// https://developer.mozilla.org/en-US/docs/Web/JavaScript/Reference/Global_Objects/WeakRef/WeakRef
+func (w weakRefJS) New(target js.Value) WeakRefValue {
+	res := js.Value(w).New(target)
+	return WeakRefValue(res)
+}
+
+// Deref wraps the WeakRef deref instance method.
+//
+// https://developer.mozilla.org/en-US/docs/Web/JavaScript/Reference/Global_Objects/WeakRef/deref
+func (w WeakRefValue) Deref() js.Value {
+	return js.Value(w).Call("deref")
+}
@@ -0,0 +1,17 @@
+//go:build js
+
+package goji
+
+import (
+	"syscall/js"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWeakRefDeref(t *testing.T) {
+	target := js.Global().Get("Object").New()
+
+	ref := WeakRef.New(target)
+	assert.True(t, ref.Deref().Equal(target))
+}
@@ -22,6 +22,12 @@ func Compile(bufferSource js.Value) goji.PromiseValue {
 	return goji.PromiseValue(res)
 }
 
+// CompileTyped is the same as Compile but returns a promise
+// that resolves to a ModuleValue.
+func CompileTyped(bufferSource js.Value) goji.TypedPromise[ModuleValue] {
+	return goji.TypedPromise[ModuleValue](Compile(bufferSource))
+}
+
 // Instantiate is a wrapper for the WebAssembly instantiate static method.
 //
 // https://developer.mozilla.org/en-US/docs/WebAssembly/JavaScript_interface/instantiate_static
@@ -30,6 +36,18 @@ func Instantiate(bufferSourceOrModule js.Value, importObject js.Value) goji.Prom
 	return goji.PromiseValue(res)
 }
 
+// InstantiateTyped is the same as Instantiate called with a buffer source
+// but returns a promise that resolves to a ResultObjectValue.
+func InstantiateTyped(bufferSource js.Value, importObject js.Value) goji.TypedPromise[ResultObjectValue] {
+	return goji.TypedPromise[ResultObjectValue](Instantiate(bufferSource, importObject))
+}
+
+// InstantiateModule is the same as Instantiate called with a compiled module
+// but returns a promise that resolves to an InstanceValue.
+func InstantiateModule(module ModuleValue, importObject js.Value) goji.TypedPromise[InstanceValue] {
+	return goji.TypedPromise[InstanceValue](Instantiate(js.Value(module), importObject))
+}
+
 // Validate is a wrapper for the WebAssembly validate static method.
 //
 // https://developer.mozilla.org/en-US/docs/WebAssembly/JavaScript_interface/validate_static
@@ -37,3 +55,37 @@ func Validate(bufferSource js.Value) bool {
 	res := webAssembly.Call("validate", bufferSource)
 	return res.Bool()
 }
+
+// ModuleValue is an instance of WebAssembly.Module.
+//
+// https://developer.mozilla.org/en-US/docs/WebAssembly/JavaScript_interface/Module
+type ModuleValue js.Value
+
+// InstanceValue is an instance of WebAssembly.Instance.
+//
+// https://developer.mozilla.org/en-US/docs/WebAssembly/JavaScript_interface/Instance
+type InstanceValue js.Value
+
+// Exports returns the WebAssembly.Instance exports property.
+//
+// https://developer.mozilla.org/en-US/docs/WebAssembly/JavaScript_interface/Instance/exports
+func (i InstanceValue) Exports() js.Value {
+	return js.Value(i).Get("exports")
+}
+
+// ResultObjectValue is the result of instantiating a buffer source.
+//
+// https://developer.mozilla.org/en-US/docs/WebAssembly/JavaScript_interface/instantiate_static#return_value
+type ResultObjectValue js.Value
+
+// Module returns the compiled WebAssembly.Module.
+func (r ResultObjectValue) Module() ModuleValue {
+	res := js.Value(r).Get("module")
+	return ModuleValue(res)
+}
+
+// Instance returns the WebAssembly.Instance.
+func (r ResultObjectValue) Instance() InstanceValue {
+	res := js.Value(r).Get("instance")
+	return InstanceValue(res)
+}
@@ -3,10 +3,12 @@
 package web_assembly
 
 import (
+	"context"
 	_ "embed"
 	"syscall/js"
 	"testing"
 
+	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
 	"github.com/sourcenetwork/goji"
@@ -27,6 +29,15 @@ func TestCompile(t *testing.T) {
 	require.Len(t, res, 1)
 }
 
+func TestCompileTyped(t *testing.T) {
+	src := goji.Uint8ArrayFromBytes(wasmBytes)
+
+	prom := CompileTyped(js.Value(src))
+	module, err := prom.Await(context.Background())
+	require.NoError(t, err)
+	assert.True(t, js.Value(module).Truthy())
+}
+
 func TestInstantiate(t *testing.T) {
 	src := goji.Uint8ArrayFromBytes(wasmBytes)
 	importObject := js.ValueOf(map[string]any{})
@@ -39,3 +50,45 @@ func TestInstantiate(t *testing.T) {
 	require.NoError(t, err)
 	require.Len(t, res, 1)
 }
+
+func TestInstantiateCompiledModule(t *testing.T) {
+	src := goji.Uint8ArrayFromBytes(wasmBytes)
+	importObject := js.ValueOf(map[string]any{})
+
+	module, err := goji.Await(Compile(js.Value(src)))
+	require.NoError(t, err)
+	require.Len(t, module, 1)
+
+	res, err := goji.Await(Instantiate(module[0], importObject))
+	require.NoError(t, err)
+	require.Len(t, res, 1)
+
+	addTwo := res[0].Get("exports").Get("addTwo")
+	assert.Equal(t, 3, addTwo.Invoke(1, 2).Int())
+}
+
+func TestInstantiateTyped(t *testing.T) {
+	src := goji.Uint8ArrayFromBytes(wasmBytes)
+	importObject := js.ValueOf(map[string]any{})
+
+	prom := InstantiateTyped(js.Value(src), importObject)
+	res, err := prom.Await(context.Background())
+	require.NoError(t, err)
+
+	addTwo := res.Instance().Exports().Get("addTwo")
+	assert.Equal(t, 3, addTwo.Invoke(1, 2).Int())
+}
+
+func TestInstantiateModule(t *testing.T) {
+	src := goji.Uint8ArrayFromBytes(wasmBytes)
+	importObject := js.ValueOf(map[string]any{})
+
+	module, err := CompileTyped(js.Value(src)).Await(context.Background())
+	require.NoError(t, err)
+
+	instance, err := InstantiateModule(module, importObject).Await(context.Background())
+	require.NoError(t, err)
+
+	addTwo := instance.Exports().Get("addTwo")
+	assert.Equal(t, 3, addTwo.Invoke(1, 2).Int())
+}
@@ -51,6 +51,12 @@ func (w WebTransportValue) Closed() goji.PromiseValue {
 	return goji.PromiseValue(js.Value(w).Get("closed"))
 }
 
+// ClosedTyped is the same as Closed but returns a promise
+// that resolves to a WebTransportCloseInfo.
+func (w WebTransportValue) ClosedTyped() goji.TypedPromise[WebTransportCloseInfo] {
+	return goji.TypedPromise[WebTransportCloseInfo](w.Closed())
+}
+
 // Datagrams returns the WebTransport.datagrams property.
 //
 // https://developer.mozilla.org/en-US/docs/Web/API/WebTransport/datagrams
@@ -82,9 +88,9 @@ func (w WebTransportValue) IncomingUnidirectionalStreams() streams.ReadableStrea
 // WebTransportCloseInfo provides additional info when closing a web transport.
 type WebTransportCloseInfo struct {
 	// CloseCode is a number representing the error code for the error.
-	CloseCode int
+	CloseCode int `json:"closeCode"`
 	// Reason is a string representing the reason for closing the WebTransport.
-	Reason string
+	Reason string `json:"reason"`
 }
 
 // Close wraps the WebTransport.close method.
@@ -136,6 +142,12 @@ func (w WebTransportValue) CreateBidirectionalStream(opts ...webTransportOption)
 	}
 }
 
+// CreateBidirectionalStreamTyped is the same as CreateBidirectionalStream but returns a promise
+// that resolves to a WebTransportBidirectionalStreamValue.
+func (w WebTransportValue) CreateBidirectionalStreamTyped(opts ...webTransportOption) goji.TypedPromise[WebTransportBidirectionalStreamValue] {
+	return goji.TypedPromise[WebTransportBidirectionalStreamValue](w.CreateBidirectionalStream(opts...))
+}
+
 // CreateUnidirectionalStream wraps the WebTransport.createUnidirectionalStream method.
 //
 // https://developer.mozilla.org/en-US/docs/Web/API/WebTransport/createUnidirectionalStream
@@ -155,6 +167,12 @@ func (w WebTransportValue) CreateUnidirectionalStream(opts ...webTransportOption
 	}
 }
 
+// CreateUnidirectionalStreamTyped is the same as CreateUnidirectionalStream but returns a promise
+// that resolves to a WritableStreamValue.
+func (w WebTransportValue) CreateUnidirectionalStreamTyped(opts ...webTransportOption) goji.TypedPromise[streams.WritableStreamValue] {
+	return goji.TypedPromise[streams.WritableStreamValue](w.CreateUnidirectionalStream(opts...))
+}
+
 // CongestionControl specifies the available congestion control algorithms.
 type CongestionControl string
 
@@ -279,46 +297,38 @@ func (v WebTransportDatagramDuplexStreamValue) SetOutgoingHighWaterMark(value in
 // IncomingMaxAge returns the WebTransportDatagramDuplexStream.incomingMaxAge property.
 //
 // https://developer.mozilla.org/en-US/docs/Web/API/WebTransportDatagramDuplexStream/incomingMaxAge
-func (v WebTransportDatagramDuplexStreamValue) IncomingMaxAge() *int {
+func (v WebTransportDatagramDuplexStreamValue) IncomingMaxAge() goji.Nullable[float64] {
 	res := js.Value(v).Get("incomingMaxAge")
-	if res.IsNull() {
-		return nil
-	}
-	val := res.Int()
-	return &val
+	return goji.NullableFloat(res)
 }
 
 // SetIncomingMaxAge sets the WebTransportDatagramDuplexStream.incomingMaxAge property.
 //
 // https://developer.mozilla.org/en-US/docs/Web/API/WebTransportDatagramDuplexStream/incomingMaxAge
-func (v WebTransportDatagramDuplexStreamValue) SetIncomingMaxAge(value *int) {
-	if value == nil {
-		js.Value(v).Set("incomingMaxAge", js.Null())
+func (v WebTransportDatagramDuplexStreamValue) SetIncomingMaxAge(value goji.Nullable[float64]) {
+	if val, ok := value.Get(); ok {
+		js.Value(v).Set("incomingMaxAge", val)
 	} else {
-		js.Value(v).Set("incomingMaxAge", *value)
+		js.Value(v).Set("incomingMaxAge", js.Null())
 	}
 }
 
 // OutgoingMaxAge returns the WebTransportDatagramDuplexStream.outgoingMaxAge property.
 //
 // https://developer.mozilla.org/en-US/docs/Web/API/WebTransportDatagramDuplexStream/outgoingMaxAge
-func (v WebTransportDatagramDuplexStreamValue) OutgoingMaxAge() *int {
+func (v WebTransportDatagramDuplexStreamValue) OutgoingMaxAge() goji.Nullable[float64] {
 	res := js.Value(v).Get("outgoingMaxAge")
-	if res.IsNull() {
-		return nil
-	}
-	val := res.Int()
-	return &val
+	return goji.NullableFloat(res)
 }
 
 // SetOutgoingMaxAge sets the WebTransportDatagramDuplexStream.outgoingMaxAge property.
 //
 // https://developer.mozilla.org/en-US/docs/Web/API/WebTransportDatagramDuplexStream/outgoingMaxAge
-func (v WebTransportDatagramDuplexStreamValue) SetOutgoingMaxAge(value *int) {
-	if value == nil {
-		js.Value(v).Set("outgoingMaxAge", js.Null())
+func (v WebTransportDatagramDuplexStreamValue) SetOutgoingMaxAge(value goji.Nullable[float64]) {
+	if val, ok := value.Get(); ok {
+		js.Value(v).Set("outgoingMaxAge", val)
 	} else {
-		js.Value(v).Set("outgoingMaxAge", *value)
+		js.Value(v).Set("outgoingMaxAge", js.Null())
 	}
 }
 
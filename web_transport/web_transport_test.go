@@ -3,6 +3,7 @@
 package web_transport
 
 import (
+	"context"
 	"encoding/hex"
 	"io"
 	"net/http"
@@ -24,10 +25,9 @@ func TestWebTransportBidirectionalStream(t *testing.T) {
 	_, err = goji.Await(webTransport.Ready())
 	require.NoError(t, err, "web transport failed to connect")
 
-	streamRes, err := goji.Await(webTransport.CreateBidirectionalStream())
+	stream, err := webTransport.CreateBidirectionalStreamTyped().Await(context.Background())
 	require.NoError(t, err, "failed to create bidirectional stream")
 
-	stream := WebTransportBidirectionalStreamValue(streamRes[0])
 	writer := streams.NewWriter(stream.Writable().GetWriter())
 	reader := streams.NewReader(stream.Readable().GetBYOBReader())
 